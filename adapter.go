@@ -0,0 +1,424 @@
+package slice
+
+type mapIterator[T, U any] struct {
+	iter Iterator[T]
+	f    func(T) U
+}
+
+// MapIter creates an iterator that calls f on each element of the given
+// iterator. The elements are mapped lazily, every time Get is called. The
+// iterator can't be used to set elements, so Set panics
+func MapIter[T, U any](i Iterator[T], f func(T) U) Iterator[U] {
+	return &mapIterator[T, U]{iter: i, f: f}
+}
+
+func (i *mapIterator[T, U]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *mapIterator[T, U]) Next() bool {
+	return i.iter.Next()
+}
+
+func (i *mapIterator[T, U]) HasPrev() bool {
+	return i.iter.HasPrev()
+}
+
+func (i *mapIterator[T, U]) Prev() bool {
+	return i.iter.Prev()
+}
+
+func (i *mapIterator[T, U]) Get() U {
+	return i.f(i.iter.Get())
+}
+
+// Set always panics, as a mapped element can't be set
+func (i *mapIterator[T, U]) Set(U) {
+	panic("slice: can't set the element of a mapped iterator")
+}
+
+type filterIterator[T any] struct {
+	iter Iterator[T]
+	keep func(T) bool
+	// The element the iterator is pointed to
+	elem T
+	// The number of elements the underlying iterator is ahead of the element
+	// the iterator is pointed to, or behind if negative. The underlying
+	// iterator is moved ahead (or behind) by HasNext (or HasPrev), to look for
+	// the next element that is kept
+	offset int
+	// Whether the underlying iterator is pointed to an element that is kept
+	kept bool
+}
+
+// FilterIter creates an iterator that skips over the elements of the given
+// iterator that keep returns false for. Checking for a next (or previous)
+// element moves the given iterator, so if it can't move backwards, Set panics
+// after HasNext until Next is called
+func FilterIter[T any](i Iterator[T], keep func(T) bool) Iterator[T] {
+	return &filterIterator[T]{iter: i, keep: keep}
+}
+
+// Moves the underlying iterator back to the element the iterator is pointed
+// to. Returns false if the underlying iterator can't be moved backwards
+func (i *filterIterator[T]) restore() bool {
+	for ; i.offset > 0; i.offset-- {
+		if !i.iter.Prev() {
+			return false
+		}
+	}
+	for ; i.offset < 0; i.offset++ {
+		i.iter.Next()
+	}
+	return true
+}
+
+// Moves the underlying iterator to the next (or previous) element that is kept
+func (i *filterIterator[T]) seek(forwards bool) bool {
+	for {
+		// Move the underlying iterator
+		if forwards {
+			if !i.iter.Next() {
+				return false
+			}
+			i.offset++
+		} else {
+			if !i.iter.Prev() {
+				return false
+			}
+			i.offset--
+		}
+
+		if i.keep(i.iter.Get()) {
+			return true
+		}
+	}
+}
+
+func (i *filterIterator[T]) HasNext() bool {
+	// If the underlying iterator hasn't already looked ahead
+	if i.offset <= 0 {
+		i.restore()
+		i.kept = i.seek(true)
+	}
+	return i.kept
+}
+
+func (i *filterIterator[T]) Next() bool {
+	if i.HasNext() {
+		// The underlying iterator is now pointed to the next element
+		i.elem = i.iter.Get()
+		i.offset = 0
+		i.kept = false
+		return true
+	}
+	return false
+}
+
+func (i *filterIterator[T]) HasPrev() bool {
+	// If the underlying iterator hasn't already looked behind
+	if i.offset >= 0 {
+		if !i.restore() {
+			return false
+		}
+		i.kept = i.seek(false)
+	}
+	return i.kept
+}
+
+func (i *filterIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		// The underlying iterator is now pointed to the previous element
+		i.elem = i.iter.Get()
+		i.offset = 0
+		i.kept = false
+		return true
+	}
+	return false
+}
+
+func (i *filterIterator[T]) Get() T {
+	return i.elem
+}
+
+func (i *filterIterator[T]) Set(elem T) {
+	if !i.restore() {
+		panic("slice: can't move the iterator back to the current element")
+	}
+	i.iter.Set(elem)
+	i.elem = elem
+}
+
+type takeIterator[T any] struct {
+	Iterator[T]
+	n int
+	// The position of the iterator, where 1 is the first element
+	pos int
+}
+
+// TakeIter creates an iterator over (at most) the first n elements of the
+// given iterator
+func TakeIter[T any](i Iterator[T], n int) Iterator[T] {
+	return &takeIterator[T]{Iterator: i, n: n}
+}
+
+func (i *takeIterator[T]) HasNext() bool {
+	return i.pos < i.n && i.Iterator.HasNext()
+}
+
+func (i *takeIterator[T]) Next() bool {
+	if i.pos < i.n && i.Iterator.Next() {
+		i.pos++
+		return true
+	}
+	return false
+}
+
+func (i *takeIterator[T]) HasPrev() bool {
+	return i.pos > 1 && i.Iterator.HasPrev()
+}
+
+func (i *takeIterator[T]) Prev() bool {
+	if i.pos > 1 && i.Iterator.Prev() {
+		i.pos--
+		return true
+	}
+	return false
+}
+
+type skipIterator[T any] struct {
+	Iterator[T]
+	n int
+	// The position of the underlying iterator, where 1 is the first element
+	pos int
+}
+
+// SkipIter creates an iterator that skips over the first n elements of the
+// given iterator. The elements are skipped the first time the iterator is
+// moved
+func SkipIter[T any](i Iterator[T], n int) Iterator[T] {
+	return &skipIterator[T]{Iterator: i, n: n}
+}
+
+// Skips the first n elements, if they haven't been skipped already
+func (i *skipIterator[T]) skip() {
+	for i.pos < i.n && i.Iterator.Next() {
+		i.pos++
+	}
+}
+
+func (i *skipIterator[T]) HasNext() bool {
+	i.skip()
+	return i.Iterator.HasNext()
+}
+
+func (i *skipIterator[T]) Next() bool {
+	i.skip()
+	if i.Iterator.Next() {
+		i.pos++
+		return true
+	}
+	return false
+}
+
+func (i *skipIterator[T]) HasPrev() bool {
+	return i.pos > i.n+1 && i.Iterator.HasPrev()
+}
+
+func (i *skipIterator[T]) Prev() bool {
+	if i.pos > i.n+1 && i.Iterator.Prev() {
+		i.pos--
+		return true
+	}
+	return false
+}
+
+type chainIterator[T any] struct {
+	iters []Iterator[T]
+	// Whether each iterator has been pointed to an element
+	started []bool
+	// The index of the current iterator
+	index int
+}
+
+// ChainIter creates an iterator over the elements of each of the given
+// iterators in turn. The given iterators should be pointed to their start
+func ChainIter[T any](iters ...Iterator[T]) Iterator[T] {
+	return &chainIterator[T]{
+		iters:   iters,
+		started: make([]bool, len(iters)),
+	}
+}
+
+func (i *chainIterator[T]) HasNext() bool {
+	if i.index < len(i.iters) && i.iters[i.index].HasNext() {
+		return true
+	}
+	// Check if any of the next iterators have an element
+	for k := i.index + 1; k < len(i.iters); k++ {
+		if i.started[k] || i.iters[k].HasNext() {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *chainIterator[T]) Next() bool {
+	if i.index < len(i.iters) && i.iters[i.index].Next() {
+		i.started[i.index] = true
+		return true
+	}
+	// Find the next iterator with an element
+	for k := i.index + 1; k < len(i.iters); k++ {
+		// If the iterator was started, it's still pointed to its first element
+		if i.started[k] {
+			i.index = k
+			return true
+		}
+		if i.iters[k].Next() {
+			i.started[k] = true
+			i.index = k
+			return true
+		}
+	}
+	return false
+}
+
+func (i *chainIterator[T]) HasPrev() bool {
+	if i.index < len(i.iters) && i.started[i.index] && i.iters[i.index].HasPrev() {
+		return true
+	}
+	// Check if any of the previous iterators have an element
+	for k := i.index - 1; k >= 0; k-- {
+		if i.started[k] {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *chainIterator[T]) Prev() bool {
+	if i.index < len(i.iters) && i.started[i.index] && i.iters[i.index].Prev() {
+		return true
+	}
+	// Find the previous iterator with an element, which is still pointed to its
+	// last element
+	for k := i.index - 1; k >= 0; k-- {
+		if i.started[k] {
+			i.index = k
+			return true
+		}
+	}
+	return false
+}
+
+func (i *chainIterator[T]) Get() T {
+	return i.iters[i.index].Get()
+}
+
+func (i *chainIterator[T]) Set(elem T) {
+	i.iters[i.index].Set(elem)
+}
+
+// Pair is a pair of elements, created by ZipIter
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+type zipIterator[T, U any] struct {
+	first  Iterator[T]
+	second Iterator[U]
+}
+
+// ZipIter creates an iterator over pairs of elements from the given iterators.
+// The iterator stops when either of the given iterators does
+func ZipIter[T, U any](first Iterator[T], second Iterator[U]) Iterator[Pair[T, U]] {
+	return &zipIterator[T, U]{first: first, second: second}
+}
+
+func (i *zipIterator[T, U]) HasNext() bool {
+	return i.first.HasNext() && i.second.HasNext()
+}
+
+func (i *zipIterator[T, U]) Next() bool {
+	if i.HasNext() {
+		i.first.Next()
+		i.second.Next()
+		return true
+	}
+	return false
+}
+
+func (i *zipIterator[T, U]) HasPrev() bool {
+	return i.first.HasPrev() && i.second.HasPrev()
+}
+
+func (i *zipIterator[T, U]) Prev() bool {
+	if i.HasPrev() {
+		i.first.Prev()
+		i.second.Prev()
+		return true
+	}
+	return false
+}
+
+func (i *zipIterator[T, U]) Get() Pair[T, U] {
+	return Pair[T, U]{First: i.first.Get(), Second: i.second.Get()}
+}
+
+func (i *zipIterator[T, U]) Set(pair Pair[T, U]) {
+	i.first.Set(pair.First)
+	i.second.Set(pair.Second)
+}
+
+type generateIterator[T any] struct {
+	f     func(int) T
+	index int
+}
+
+// Generate creates an infinite iterator, where each element is the result of
+// calling f with the element's index. The iterator can't be used to set
+// elements, so Set panics
+func Generate[T any](f func(int) T) Iterator[T] {
+	return &generateIterator[T]{f: f, index: -1}
+}
+
+// Repeat creates an infinite iterator, where every element is elem
+func Repeat[T any](elem T) Iterator[T] {
+	return Generate(func(int) T {
+		return elem
+	})
+}
+
+// HasNext always returns true
+func (i *generateIterator[T]) HasNext() bool {
+	return true
+}
+
+// Next always returns true
+func (i *generateIterator[T]) Next() bool {
+	i.index++
+	return true
+}
+
+func (i *generateIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *generateIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *generateIterator[T]) Get() T {
+	return i.f(i.index)
+}
+
+// Set always panics, as a generated element can't be set
+func (i *generateIterator[T]) Set(T) {
+	panic("slice: can't set the element of a generated iterator")
+}
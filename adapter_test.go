@@ -0,0 +1,187 @@
+package slice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Collects the elements of an iterator, by moving it forwards
+func collectNext[T any](iter Iterator[T]) []T {
+	var elems []T
+	for iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+	return elems
+}
+
+// Collects the elements of an iterator, by moving it backwards
+func collectPrev[T any](iter Iterator[T]) []T {
+	var elems []T
+	for iter.Prev() {
+		elems = append(elems, iter.Get())
+	}
+	return elems
+}
+
+func commonIterMapTest(t *testing.T, s Slice[int]) {
+	iter := MapIter(s.Append(1, 2, 3).IterStart(), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3"}, collectNext(iter)[s.Len():])
+	assert.Panics(t, func() {
+		iter.Set("4")
+	})
+}
+
+func commonIterFilterTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2, 3, 4, 5, 6)
+	odd := func(elem int) bool {
+		return elem%2 == 1
+	}
+
+	iter := FilterIter(s1.IterStart(), odd)
+	assert.True(t, iter.HasNext())
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, []int{1, 3, 5}, collectNext(iter))
+	assert.False(t, iter.HasNext())
+	assert.Equal(t, 5, iter.Get())
+
+	iter = FilterIter(s1.IterStart(), func(elem int) bool {
+		return false
+	})
+	assert.False(t, iter.HasNext())
+	assert.False(t, iter.Next())
+
+	iter = FilterIter(s1.IterStart(), odd)
+	iter.Next()
+	iter.Set(7)
+	assert.Equal(t, 7, s1.Get(0))
+}
+
+func commonIterFilterReverseTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2, 3, 4, 5, 6)
+	iter := FilterIter(s1.IterStart(), func(elem int) bool {
+		return elem%2 == 0
+	})
+	assert.Equal(t, []int{2, 4, 6}, collectNext(iter))
+	assert.Equal(t, []int{4, 2}, collectPrev(iter))
+	assert.False(t, iter.HasPrev())
+
+	// Set after looking ahead should still set the current element
+	assert.True(t, iter.HasNext())
+	iter.Set(8)
+	assert.Equal(t, 8, s1.Get(1))
+	assert.True(t, iter.Next())
+	assert.Equal(t, 4, iter.Get())
+}
+
+func commonIterTakeSkipTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2, 3, 4, 5)
+	assert.Equal(t, []int{1, 2, 3}, collectNext(TakeIter(s1.IterStart(), 3)))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, collectNext(TakeIter(s1.IterStart(), 10)))
+	assert.Nil(t, collectNext(TakeIter(s1.IterStart(), 0)))
+
+	assert.Equal(t, []int{3, 4, 5}, collectNext(SkipIter(s1.IterStart(), 2)))
+	assert.Nil(t, collectNext(SkipIter(s1.IterStart(), 10)))
+
+	iter := TakeIter(SkipIter(s1.IterStart(), 1), 3)
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, []int{2, 3, 4}, collectNext(iter))
+}
+
+func commonIterTakeSkipReverseTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2, 3, 4, 5)
+	iter := TakeIter(SkipIter(s1.IterStart(), 1), 3)
+	collectNext(iter)
+	assert.Equal(t, []int{3, 2}, collectPrev(iter))
+}
+
+func commonIterChainTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2)
+	s2 := s.Slice(0, 0).Append(3)
+	empty := s.Slice(0, 0)
+
+	iter := ChainIter(empty.IterStart(), s1.IterStart(), empty.IterStart(), s2.IterStart())
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, []int{1, 2, 3}, collectNext(iter))
+	assert.False(t, iter.HasNext())
+}
+
+func commonIterChainReverseTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2)
+	s2 := s.Slice(0, 0).Append(3)
+	empty := s.Slice(0, 0)
+
+	iter := ChainIter(s1.IterStart(), empty.IterStart(), s2.IterStart())
+	assert.Equal(t, []int{1, 2, 3}, collectNext(iter))
+	assert.Equal(t, []int{2, 1}, collectPrev(iter))
+	assert.False(t, iter.HasPrev())
+	assert.Equal(t, []int{2, 3}, collectNext(iter))
+}
+
+func commonIterZipTest(t *testing.T, s Slice[int]) {
+	s1 := s.Slice(0, 0).Append(1, 2, 3)
+	iter := ZipIter(s1.IterStart(), Wrap([]string{"a", "b"}).IterStart())
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, collectNext(iter))
+
+	iter.Set(Pair[int, string]{First: 4, Second: "c"})
+	assert.Equal(t, 4, s1.Get(1))
+}
+
+func TestWrapper_IterAdapters(t *testing.T) {
+	commonIterMapTest(t, Wrap([]int{1}))
+	commonIterFilterTest(t, EmptySlice[int](0, 0))
+	commonIterFilterReverseTest(t, EmptySlice[int](0, 0))
+	commonIterTakeSkipTest(t, EmptySlice[int](0, 0))
+	commonIterTakeSkipReverseTest(t, EmptySlice[int](0, 0))
+	commonIterChainTest(t, EmptySlice[int](0, 0))
+	commonIterChainReverseTest(t, EmptySlice[int](0, 0))
+	commonIterZipTest(t, EmptySlice[int](0, 0))
+}
+
+func TestDistributed_IterAdapters(t *testing.T) {
+	commonIterMapTest(t, DistributedFrom([]int{1}))
+	commonIterFilterTest(t, EmptyDistributed[int](0, 2))
+	commonIterFilterReverseTest(t, EmptyDistributed[int](0, 2))
+	commonIterTakeSkipTest(t, EmptyDistributed[int](0, 2))
+	commonIterTakeSkipReverseTest(t, EmptyDistributed[int](0, 2))
+	commonIterChainTest(t, EmptyDistributed[int](0, 2))
+	commonIterChainReverseTest(t, EmptyDistributed[int](0, 2))
+	commonIterZipTest(t, EmptyDistributed[int](0, 2))
+}
+
+func TestSingly_IterAdapters(t *testing.T) {
+	commonIterMapTest(t, SinglyFrom([]int{1}))
+	commonIterFilterTest(t, EmptySingly[int]())
+	commonIterTakeSkipTest(t, EmptySingly[int]())
+	commonIterChainTest(t, EmptySingly[int]())
+	commonIterZipTest(t, EmptySingly[int]())
+}
+
+func TestDoubly_IterAdapters(t *testing.T) {
+	commonIterMapTest(t, DoublyFrom([]int{1}))
+	commonIterFilterTest(t, EmptyDoubly[int]())
+	commonIterFilterReverseTest(t, EmptyDoubly[int]())
+	commonIterTakeSkipTest(t, EmptyDoubly[int]())
+	commonIterTakeSkipReverseTest(t, EmptyDoubly[int]())
+	commonIterChainTest(t, EmptyDoubly[int]())
+	commonIterChainReverseTest(t, EmptyDoubly[int]())
+	commonIterZipTest(t, EmptyDoubly[int]())
+}
+
+func TestGenerate(t *testing.T) {
+	iter := Generate(func(i int) int {
+		return i * i
+	})
+	assert.False(t, iter.HasPrev())
+	assert.Equal(t, []int{0, 1, 4, 9}, collectNext(TakeIter(iter, 4)))
+	assert.True(t, iter.Prev())
+	assert.Equal(t, 4, iter.Get())
+
+	assert.Equal(t, []string{"a", "a", "a"}, collectNext(TakeIter(Repeat("a"), 3)))
+	assert.Equal(t, []int{1, 9, 25}, collectNext(TakeIter(FilterIter(Generate(func(i int) int {
+		return i * i
+	}), func(elem int) bool {
+		return elem%2 == 1
+	}), 3)))
+}
@@ -0,0 +1,286 @@
+package slice
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// The chunked binary format starts with a header:
+//
+//	magic       [4]byte "SLCK"
+//	version     uint16
+//	record size uint32, the Size of the Codec the elements were encoded with
+//
+// Then any number of chunks, until the end of the stream:
+//
+//	count   uint32, the number of elements in the chunk
+//	records [count * record size]byte, the elements encoded with the Codec
+//	crc     uint32, the CRC-32 (IEEE) of count and records
+//
+// All integers are big-endian. As the chunks don't depend on each other,
+// chunks can be appended onto an existing stream with ResumeChunkWriter, using
+// DefaultChunkCodec if the stream was written by WriteTo
+const (
+	chunkMagic      = "SLCK"
+	chunkVersion    = 1
+	chunkHeaderSize = 4 + 2 + 4
+	// The most bytes of records a chunk can have, so a corrupted count can't
+	// make the reader allocate too much memory
+	chunkMaxBytes = 1 << 30
+)
+
+// ErrChunkChecksum is returned when a chunk's records don't match its CRC
+var ErrChunkChecksum = errors.New("slice: chunk checksum mismatch")
+
+// ChunkWriter writes slices in the chunked binary format
+type ChunkWriter[T any] struct {
+	w     io.Writer
+	codec Codec[T]
+	buf   []byte
+	n     int64
+}
+
+// NewChunkWriter writes the header of a new stream to w, and creates a
+// ChunkWriter that writes chunks after it
+func NewChunkWriter[T any](w io.Writer, codec Codec[T]) (*ChunkWriter[T], error) {
+	cw := ResumeChunkWriter(w, codec)
+	if err := cw.writeHeader(); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// ResumeChunkWriter creates a ChunkWriter that writes chunks onto the end of
+// an existing stream, such as a file opened with os.O_APPEND. No header is
+// written, so the codec must be the same as the one the stream was created
+// with
+func ResumeChunkWriter[T any](w io.Writer, codec Codec[T]) *ChunkWriter[T] {
+	return &ChunkWriter[T]{w: w, codec: codec}
+}
+
+func (w *ChunkWriter[T]) writeHeader() error {
+	header := make([]byte, chunkHeaderSize)
+	copy(header, chunkMagic)
+	binary.BigEndian.PutUint16(header[4:], chunkVersion)
+	binary.BigEndian.PutUint32(header[6:], uint32(w.codec.Size()))
+	return w.write(header)
+}
+
+func (w *ChunkWriter[T]) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.n += int64(n)
+	return err
+}
+
+// WriteChunk writes the elements as one chunk. The chunk is written with a
+// single call to Write
+func (w *ChunkWriter[T]) WriteChunk(elems []T) error {
+	size := w.codec.Size()
+	length := 4 + len(elems)*size + 4
+	if cap(w.buf) < length {
+		w.buf = make([]byte, length)
+	}
+	buf := w.buf[:length]
+
+	binary.BigEndian.PutUint32(buf, uint32(len(elems)))
+	for i, elem := range elems {
+		w.codec.Encode(buf[4+i*size:4+(i+1)*size:4+(i+1)*size], elem)
+	}
+	binary.BigEndian.PutUint32(buf[length-4:], crc32.ChecksumIEEE(buf[:length-4]))
+	return w.write(buf)
+}
+
+// BytesWritten gets the number of bytes that have been written, including the
+// header
+func (w *ChunkWriter[T]) BytesWritten() int64 {
+	return w.n
+}
+
+// ChunkReader reads slices in the chunked binary format
+type ChunkReader[T any] struct {
+	r     io.Reader
+	codec Codec[T]
+	buf   []byte
+	n     int64
+}
+
+// NewChunkReader reads the header of a stream from r, and creates a
+// ChunkReader that reads the chunks after it. The codec must have the same
+// record size as the one the stream was written with
+func NewChunkReader[T any](r io.Reader, codec Codec[T]) (*ChunkReader[T], error) {
+	cr := &ChunkReader[T]{r: r, codec: codec}
+	if err := cr.readHeader(); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+func (r *ChunkReader[T]) readHeader() error {
+	header := make([]byte, chunkHeaderSize)
+	if err := r.read(header); err != nil {
+		return err
+	}
+
+	if string(header[:4]) != chunkMagic {
+		return errors.New("slice: not a chunked stream")
+	}
+	if version := binary.BigEndian.Uint16(header[4:]); version != chunkVersion {
+		return fmt.Errorf("slice: unsupported chunked stream version %d", version)
+	}
+	if size := int(binary.BigEndian.Uint32(header[6:])); size != r.codec.Size() {
+		return fmt.Errorf("slice: stream has %d byte records, the codec has %d byte records",
+			size, r.codec.Size())
+	}
+	return nil
+}
+
+func (r *ChunkReader[T]) read(data []byte) error {
+	n, err := io.ReadFull(r.r, data)
+	r.n += int64(n)
+	return err
+}
+
+// ReadChunk reads the next chunk, appending its elements onto dst, and
+// returns the extended slice. It returns io.EOF when there are no more
+// chunks, and io.ErrUnexpectedEOF if the stream ends part way through a chunk
+func (r *ChunkReader[T]) ReadChunk(dst []T) ([]T, error) {
+	var count [4]byte
+	if err := r.read(count[:]); err != nil {
+		return dst, err
+	}
+
+	// The size is checked in 64 bits, as the count could overflow an int
+	size := r.codec.Size()
+	records := binary.BigEndian.Uint32(count[:])
+	if uint64(records)*uint64(size) > chunkMaxBytes {
+		return dst, fmt.Errorf("slice: chunk of %d records is too big", records)
+	}
+	n := int(records)
+	length := 4 + n*size + 4
+	if cap(r.buf) < length {
+		r.buf = make([]byte, length)
+	}
+	buf := r.buf[:length]
+	copy(buf, count[:])
+	if err := r.read(buf[4:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return dst, err
+	}
+	if binary.BigEndian.Uint32(buf[length-4:]) != crc32.ChecksumIEEE(buf[:length-4]) {
+		return dst, ErrChunkChecksum
+	}
+
+	for i := 0; i < n; i++ {
+		dst = append(dst, r.codec.Decode(buf[4+i*size:4+(i+1)*size:4+(i+1)*size]))
+	}
+	return dst, nil
+}
+
+// BytesRead gets the number of bytes that have been read, including the
+// header
+func (r *ChunkReader[T]) BytesRead() int64 {
+	return r.n
+}
+
+// A Codec for int and uint, which aren't fixed-size, so they're encoded as 64
+// bits
+type wideIntCodec[T int | uint] struct{}
+
+func (wideIntCodec[T]) Size() int {
+	return 8
+}
+
+func (wideIntCodec[T]) Encode(record []byte, elem T) {
+	binary.LittleEndian.PutUint64(record, uint64(elem))
+}
+
+func (wideIntCodec[T]) Decode(record []byte) T {
+	return T(binary.LittleEndian.Uint64(record))
+}
+
+// DefaultChunkCodec gets the Codec WriteTo and ReadFrom use, which is a
+// little-endian BinaryCodec, except that int and uint are encoded as 64 bits.
+// It can be given to ResumeChunkWriter to append chunks onto a stream written
+// by WriteTo. An error is returned if T isn't a fixed-size type
+func DefaultChunkCodec[T any]() (Codec[T], error) {
+	var elem T
+	switch any(elem).(type) {
+	case int:
+		return any(wideIntCodec[int]{}).(Codec[T]), nil
+	case uint:
+		return any(wideIntCodec[uint]{}).(Codec[T]), nil
+	}
+	if binary.Size(elem) < 0 {
+		return nil, fmt.Errorf("slice: %T isn't a fixed-size type, use a ChunkWriter with a Codec", elem)
+	}
+	return BinaryCodec[T](binary.LittleEndian), nil
+}
+
+// WriteChunks writes each bucket of the slice as a chunk. Empty buckets are
+// skipped
+func (s Distributed[T]) WriteChunks(w *ChunkWriter[T]) error {
+	for b := range s.buckets {
+		if elems := s.bucket(b); len(elems) > 0 {
+			if err := w.WriteChunk(elems); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadChunks reads the rest of the chunks from r, appending their elements
+// onto the slice one chunk at a time
+func (s *Distributed[T]) ReadChunks(r *ChunkReader[T]) error {
+	var elems []T
+	for {
+		var err error
+		elems, err = r.ReadChunk(elems[:0])
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		*s = s.Append(elems...).(Distributed[T])
+	}
+}
+
+// WriteTo writes the slice to w in the chunked binary format, with one chunk
+// for each bucket. The elements are encoded with a little-endian BinaryCodec,
+// except that int and uint are encoded as 64 bits (see DefaultChunkCodec). For
+// other types, use NewChunkWriter and WriteChunks with a Codec
+func (s Distributed[T]) WriteTo(w io.Writer) (int64, error) {
+	codec, err := DefaultChunkCodec[T]()
+	if err != nil {
+		return 0, err
+	}
+	cw := ResumeChunkWriter(w, codec)
+	if err := cw.writeHeader(); err != nil {
+		return cw.BytesWritten(), err
+	}
+	err = s.WriteChunks(cw)
+	return cw.BytesWritten(), err
+}
+
+// ReadFrom reads a stream written by WriteTo from r, appending the elements
+// onto the slice. If the slice has no bucket capacity, the default is used
+func (s *Distributed[T]) ReadFrom(r io.Reader) (int64, error) {
+	codec, err := DefaultChunkCodec[T]()
+	if err != nil {
+		return 0, err
+	}
+	if s.bucketCap == 0 {
+		*s = EmptyDistributed[T](0, 0).(Distributed[T])
+	}
+	cr := &ChunkReader[T]{r: r, codec: codec}
+	if err := cr.readHeader(); err != nil {
+		return cr.BytesRead(), err
+	}
+	err = s.ReadChunks(cr)
+	return cr.BytesRead(), err
+}
@@ -0,0 +1,158 @@
+package slice
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributed_WriteTo(t *testing.T) {
+	s := EmptyDistributed[int](0, 3).Prepend(1).Append(2, 3, 4, 5, 6)
+	var buf bytes.Buffer
+	n, err := s.(Distributed[int]).WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	// There should be a chunk for each bucket
+	assert.Equal(t, chunkHeaderSize+3*(4+4)+6*8, buf.Len())
+
+	var decoded Distributed[int]
+	n, err = decoded.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, decoded.ToGoSlice())
+
+	// The elements should be appended, keeping the bucket capacity
+	decoded = EmptyDistributed[int](0, 2).Append(0).(Distributed[int])
+	_, err = decoded.ReadFrom(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, decoded.bucketCap)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, decoded.ToGoSlice())
+
+	// Types that aren't fixed-size need a Codec
+	_, err = DistributedFrom([]string{"a"}).(Distributed[string]).WriteTo(&buf)
+	assert.Error(t, err)
+	_, err = DefaultChunkCodec[string]()
+	assert.Error(t, err)
+}
+
+func TestDistributed_WriteToResume(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := DistributedFrom([]int{1, 2, 3}).(Distributed[int]).WriteTo(&buf)
+	assert.NoError(t, err)
+
+	// Chunks should be able to be appended onto a stream written by WriteTo,
+	// with the default codec
+	codec, err := DefaultChunkCodec[int]()
+	assert.NoError(t, err)
+	w := ResumeChunkWriter(&buf, codec)
+	assert.NoError(t, DistributedFrom([]int{4, 5}).(Distributed[int]).WriteChunks(w))
+	assert.NoError(t, w.WriteChunk([]int{6}))
+
+	var decoded Distributed[int]
+	_, err = decoded.ReadFrom(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, decoded.ToGoSlice())
+}
+
+func TestChunkWriter_Resume(t *testing.T) {
+	codec := BinaryCodec[testReading](binary.LittleEndian)
+	readings := []testReading{{1, 0.5}, {2, 1.25}, {3, 2}, {4, 8}}
+
+	var buf bytes.Buffer
+	w, err := NewChunkWriter(&buf, codec)
+	assert.NoError(t, err)
+	assert.NoError(t, EmptyDistributed[testReading](0, 2).Append(readings[:3]...).(Distributed[testReading]).WriteChunks(w))
+
+	// Chunks should be able to be appended onto the stream later
+	w = ResumeChunkWriter(&buf, codec)
+	assert.NoError(t, w.WriteChunk(readings[3:]))
+	assert.NoError(t, w.WriteChunk(nil))
+	assert.Equal(t, int64(4+6+4+4+4), w.BytesWritten())
+
+	r, err := NewChunkReader(bytes.NewReader(buf.Bytes()), codec)
+	assert.NoError(t, err)
+	var chunks [][]testReading
+	for {
+		chunk, err := r.ReadChunk(nil)
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+	assert.Equal(t, [][]testReading{readings[:2], readings[2:3], readings[3:], nil}, chunks)
+	assert.Equal(t, int64(buf.Len()), r.BytesRead())
+
+	s := EmptyDistributed[testReading](0, 0).(Distributed[testReading])
+	r, err = NewChunkReader(&buf, codec)
+	assert.NoError(t, err)
+	assert.NoError(t, s.ReadChunks(r))
+	assert.Equal(t, readings, s.ToGoSlice())
+}
+
+func TestChunkReader_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := DistributedFrom([]int{1, 2, 3}).(Distributed[int]).WriteTo(&buf)
+	assert.NoError(t, err)
+	data := buf.Bytes()
+
+	read := func(data []byte) (Distributed[int], error) {
+		var s Distributed[int]
+		_, err := s.ReadFrom(bytes.NewReader(data))
+		return s, err
+	}
+
+	// A corrupted record should fail the checksum
+	corrupted := append([]byte{}, data...)
+	corrupted[chunkHeaderSize+4]++
+	_, err = read(corrupted)
+	assert.Equal(t, ErrChunkChecksum, err)
+
+	// The stream ending part way through a chunk should be an error, but the
+	// chunks before it should be kept
+	var two bytes.Buffer
+	two.Write(data)
+	codec, err := DefaultChunkCodec[int]()
+	assert.NoError(t, err)
+	ResumeChunkWriter(&two, codec).WriteChunk([]int{4})
+	s, err := read(two.Bytes()[:two.Len()-1])
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+	_, err = read(two.Bytes()[:len(data)+2])
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	// A count that's too big shouldn't be read, even if multiplying it by the
+	// record size would overflow an int
+	huge := append(append([]byte{}, data[:chunkHeaderSize]...), 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(huge[chunkHeaderSize:], math.MaxUint32)
+	_, err = read(huge)
+	assert.EqualError(t, err, "slice: chunk of 4294967295 records is too big")
+
+	_, err = read([]byte("not a chunked stream"))
+	assert.Error(t, err)
+	_, err = read(nil)
+	assert.Equal(t, io.EOF, err)
+
+	newer := append([]byte{}, data...)
+	newer[5] = chunkVersion + 1
+	_, err = read(newer)
+	assert.Error(t, err)
+
+	// The codec should have the same record size as the stream
+	_, err = NewChunkReader(bytes.NewReader(data), BinaryCodec[int32](binary.LittleEndian))
+	assert.Error(t, err)
+}
+
+// BENCHMARKING
+
+func BenchmarkDistributed_WriteTo(b *testing.B) {
+	s := EmptyDistributed[int](0, 0).Append(make([]int, 100000)...).(Distributed[int])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.WriteTo(io.Discard)
+	}
+}
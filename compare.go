@@ -0,0 +1,54 @@
+package slice
+
+// Equal reports whether a and b have the same length and the same elements in
+// the same order, even if they're different Slice types. Floating point NaNs
+// aren't considered equal
+func Equal[T comparable](a, b Slice[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// EqualFunc is like Equal, but uses eq to compare each pair of elements
+func EqualFunc[T, U any](a Slice[T], b Slice[U], eq func(T, U) bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	aIter, bIter := a.IterStart(), b.IterStart()
+	for aIter.Next() && bIter.Next() {
+		if !eq(aIter.Get(), bIter.Get()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare compares the elements of a and b lexicographically, even if
+// they're different Slice types. The elements are compared in order, with NaN
+// less than any other value, and the result is that of the first pair that
+// differ. If one slice runs out of elements first, it is less than the other.
+// The result is 0 if a == b, -1 if a < b and 1 if a > b
+func Compare[T Ordered](a, b Slice[T]) int {
+	return CompareFunc(a, b, compareOrdered[T])
+}
+
+// CompareFunc is like Compare, but uses cmp to compare each pair of
+// elements. The result is the first non-zero result of cmp, or if there isn't
+// one, the result of comparing the lengths
+func CompareFunc[T, U any](a Slice[T], b Slice[U], cmp func(T, U) int) int {
+	aIter, bIter := a.IterStart(), b.IterStart()
+	for {
+		aNext, bNext := aIter.Next(), bIter.Next()
+		if !aNext || !bNext {
+			if aNext {
+				return 1
+			} else if bNext {
+				return -1
+			}
+			return 0
+		}
+		if c := cmp(aIter.Get(), bIter.Get()); c != 0 {
+			return c
+		}
+	}
+}
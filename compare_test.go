@@ -0,0 +1,108 @@
+package slice
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates every Slice type with the given elements
+func allSliceTypes(elems []int) []Slice[int] {
+	slices := make([]Slice[int], len(sliceFactories))
+	for i, f := range sliceFactories {
+		slices[i] = f.from(elems)
+	}
+	return slices
+}
+
+func TestEqual(t *testing.T) {
+	// Every type should be equal to every other type with the same elements
+	for _, a := range allSliceTypes([]int{1, 2, 3}) {
+		for _, b := range allSliceTypes([]int{1, 2, 3}) {
+			assert.True(t, Equal(a, b), "%T and %T", a, b)
+		}
+		for _, b := range allSliceTypes([]int{1, 2, 4}) {
+			assert.False(t, Equal(a, b), "%T and %T", a, b)
+		}
+		for _, b := range allSliceTypes([]int{1, 2}) {
+			assert.False(t, Equal(a, b), "%T and %T", a, b)
+			assert.False(t, Equal(b, a), "%T and %T", b, a)
+		}
+	}
+
+	assert.True(t, Equal[int](Wrapper[int]{}, EmptySingly[int]()))
+	assert.True(t, Equal(DistributedFrom([]int{0, 1, 2, 3}).Slice(1, 4), Wrap([]int{1, 2, 3})))
+	assert.False(t, Equal(Wrap([]float64{math.NaN()}), Wrap([]float64{math.NaN()})))
+}
+
+func TestEqualFunc(t *testing.T) {
+	a := DoublyFrom([]int{1, 2, 3, 4})
+	b := Wrap([]string{"1", "2", "0", "4"})
+
+	// It should stop at the first difference
+	calls := 0
+	assert.False(t, EqualFunc(a, b, func(x int, y string) bool {
+		calls++
+		return strconv.Itoa(x) == y
+	}))
+	assert.Equal(t, 3, calls)
+
+	assert.True(t, EqualFunc(a, Wrap([]string{"1", "2", "3", "4"}), func(x int, y string) bool {
+		return strconv.Itoa(x) == y
+	}))
+	assert.True(t, EqualFunc(Wrap([]float64{math.NaN()}), Wrap([]float64{math.NaN()}), func(x, y float64) bool {
+		return compareOrdered(x, y) == 0
+	}))
+}
+
+func TestCompareSlices(t *testing.T) {
+	for _, a := range allSliceTypes([]int{1, 2, 3}) {
+		for _, b := range allSliceTypes([]int{1, 2, 3}) {
+			assert.Equal(t, 0, Compare(a, b), "%T and %T", a, b)
+		}
+		for _, b := range allSliceTypes([]int{1, 3}) {
+			assert.Equal(t, -1, Compare(a, b), "%T and %T", a, b)
+			assert.Equal(t, 1, Compare(b, a), "%T and %T", b, a)
+		}
+		for _, b := range allSliceTypes([]int{1, 2}) {
+			assert.Equal(t, 1, Compare(a, b), "%T and %T", a, b)
+			assert.Equal(t, -1, Compare(b, a), "%T and %T", b, a)
+		}
+	}
+
+	assert.Equal(t, 0, Compare[int](EmptyRope[int](), Wrapper[int]{}))
+	assert.Equal(t, -1, Compare[int](EmptyRope[int](), Wrap([]int{0})))
+	assert.Equal(t, -1, Compare(Wrap([]float64{math.NaN()}), Wrap([]float64{0})))
+}
+
+func TestCompareFunc(t *testing.T) {
+	a := SinglyFrom([]int{1, 2, 3})
+	b := Wrap([]string{"1", "3", "0"})
+
+	// It should stop at the first difference
+	calls := 0
+	assert.Equal(t, -1, CompareFunc(a, b, func(x int, y string) int {
+		calls++
+		n, _ := strconv.Atoi(y)
+		return compareOrdered(x, n)
+	}))
+	assert.Equal(t, 2, calls)
+
+	// The result of cmp should be returned as is
+	assert.Equal(t, 5, CompareFunc(a, a, func(x, y int) int {
+		return 5
+	}))
+}
+
+// BENCHMARKING
+
+func BenchmarkEqual(b *testing.B) {
+	elems := make([]int, 100000)
+	s1, s2 := DistributedFrom(elems), DoublyFrom(elems)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Equal(s1, s2)
+	}
+}
@@ -0,0 +1,266 @@
+package slice
+
+import (
+	"fmt"
+	"math/bits"
+	"sync/atomic"
+	"unsafe"
+)
+
+// A bucket of a ConcurrentDistributed, with a flag for each element that is set
+// once the element has been written
+type concurrentBucket[T any] struct {
+	elems []T
+	ready []uint32
+}
+
+// A segment of a ConcurrentDistributed's buckets. Segment k holds 2^k
+// buckets, so the buckets never have to be moved when the slice grows
+type concurrentSegment struct {
+	buckets []unsafe.Pointer
+}
+
+// ConcurrentDistributed is an append-only slice that many goroutines can
+// append to at the same time, without locking. Like Distributed, the elements
+// are stored in buckets, which are never moved once they're created. Writers
+// reserve space for their elements with an atomic counter, then publish new
+// buckets with compare-and-swap. Readers see a consistent prefix of the
+// elements: Len only counts the elements before the first one that is still
+// being written, and Get and the iterators only see those elements. The zero
+// value is an empty slice using DefaultDistributedBucketCapacity. A
+// ConcurrentDistributed must not be copied after it's first used
+type ConcurrentDistributed[T any] struct {
+	// The number of elements that have been reserved by writers, and the
+	// number that have been written and can be read. These are first so they
+	// are 64-bit aligned
+	reserved  int64
+	published int64
+
+	bucketCap int
+	segments  [64]unsafe.Pointer
+}
+
+// EmptyConcurrentDistributed creates an empty ConcurrentDistributed. cap is
+// the capacity of each bucket. If cap is 0, DefaultDistributedBucketCapacity
+// is used instead
+func EmptyConcurrentDistributed[T any](cap int) *ConcurrentDistributed[T] {
+	return &ConcurrentDistributed[T]{bucketCap: cap}
+}
+
+// ConcurrentDistributedFrom creates a ConcurrentDistributed from a Go slice
+func ConcurrentDistributedFrom[T any](slice []T) *ConcurrentDistributed[T] {
+	s := EmptyConcurrentDistributed[T](0)
+	s.Append(slice...)
+	return s
+}
+
+// Gets the capacity of each bucket
+func (s *ConcurrentDistributed[T]) cap() int {
+	if s.bucketCap == 0 {
+		var t T
+		return atLeast(1, int(DefaultDistributedBucketCapacity/atLeast(1, int(unsafe.Sizeof(t)))))
+	}
+	return s.bucketCap
+}
+
+// Gets the address of the pointer to the bucket with the given index,
+// creating the bucket's segment if it doesn't exist
+func (s *ConcurrentDistributed[T]) bucketSlot(b int) *unsafe.Pointer {
+	k := bits.Len(uint(b+1)) - 1
+	seg := (*concurrentSegment)(atomic.LoadPointer(&s.segments[k]))
+	if seg == nil {
+		// Try to publish a new segment. If another goroutine got there first,
+		// use its segment instead
+		seg = &concurrentSegment{buckets: make([]unsafe.Pointer, 1<<k)}
+		if !atomic.CompareAndSwapPointer(&s.segments[k], nil, unsafe.Pointer(seg)) {
+			seg = (*concurrentSegment)(atomic.LoadPointer(&s.segments[k]))
+		}
+	}
+	return &seg.buckets[b+1-1<<k]
+}
+
+// Gets the bucket with the given index, creating it if it doesn't exist
+func (s *ConcurrentDistributed[T]) bucket(b int) *concurrentBucket[T] {
+	slot := s.bucketSlot(b)
+	bkt := (*concurrentBucket[T])(atomic.LoadPointer(slot))
+	if bkt == nil {
+		cap := s.cap()
+		bkt = &concurrentBucket[T]{elems: make([]T, cap), ready: make([]uint32, cap)}
+		if !atomic.CompareAndSwapPointer(slot, nil, unsafe.Pointer(bkt)) {
+			bkt = (*concurrentBucket[T])(atomic.LoadPointer(slot))
+		}
+	}
+	return bkt
+}
+
+// Append adds the elements onto the end of the slice. It's safe to call from
+// multiple goroutines at once, and the elements of each call are kept
+// together. It returns the index of the first element
+func (s *ConcurrentDistributed[T]) Append(elems ...T) int {
+	if len(elems) == 0 {
+		return int(atomic.LoadInt64(&s.reserved))
+	}
+
+	// Reserve space for the elements
+	end := int(atomic.AddInt64(&s.reserved, int64(len(elems))))
+	start := end - len(elems)
+
+	cap := s.cap()
+	for k, elem := range elems {
+		i := start + k
+		bkt := s.bucket(i / cap)
+		bkt.elems[i%cap] = elem
+		atomic.StoreUint32(&bkt.ready[i%cap], 1)
+	}
+
+	s.publish()
+	return start
+}
+
+// AppendSlice adds the elements of the given slice onto the end of the slice,
+// in the same way as Append
+func (s *ConcurrentDistributed[T]) AppendSlice(elems Slice[T]) int {
+	return s.Append(elems.ToGoSlice()...)
+}
+
+// Moves the published length past every element that has been written. If
+// an element is still being written, the goroutine writing it moves the
+// length past it once it's done
+func (s *ConcurrentDistributed[T]) publish() {
+	cap := s.cap()
+	for {
+		published := atomic.LoadInt64(&s.published)
+		reserved := atomic.LoadInt64(&s.reserved)
+		i := published
+		for i < reserved {
+			bkt := (*concurrentBucket[T])(atomic.LoadPointer(s.bucketSlot(int(i) / cap)))
+			if bkt == nil || atomic.LoadUint32(&bkt.ready[int(i)%cap]) == 0 {
+				break
+			}
+			i++
+		}
+		if i == published || atomic.CompareAndSwapInt64(&s.published, published, i) {
+			return
+		}
+	}
+}
+
+// Len gets the number of elements that can be read
+func (s *ConcurrentDistributed[T]) Len() int {
+	return int(atomic.LoadInt64(&s.published))
+}
+
+// Get gets the element at the given index, which must be less than Len
+func (s *ConcurrentDistributed[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	return s.get(i)
+}
+
+// Gets the element at the given index, without checking it's been published
+func (s *ConcurrentDistributed[T]) get(i int) T {
+	cap := s.cap()
+	bkt := (*concurrentBucket[T])(atomic.LoadPointer(s.bucketSlot(i / cap)))
+	return bkt.elems[i%cap]
+}
+
+type concurrentDistributedIterator[T any] struct {
+	slice *ConcurrentDistributed[T]
+	// The length of the slice when the iterator was created
+	len   int
+	index int
+}
+
+func (i *concurrentDistributedIterator[T]) HasNext() bool {
+	return i.index+1 < i.len
+}
+
+func (i *concurrentDistributedIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.index++
+		return true
+	}
+	return false
+}
+
+func (i *concurrentDistributedIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *concurrentDistributedIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *concurrentDistributedIterator[T]) Get() T {
+	if i.index < 0 || i.index >= i.len {
+		panic(fmt.Sprintf("index [%d] out of range", i.index))
+	}
+	return i.slice.get(i.index)
+}
+
+// Set always panics, as the elements of a ConcurrentDistributed can't be
+// changed once they're appended
+func (i *concurrentDistributedIterator[T]) Set(T) {
+	panic("slice: can't set an element of a ConcurrentDistributed")
+}
+
+func (i *concurrentDistributedIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *concurrentDistributedIterator[T]) Seek(index int) {
+	if index < -1 || index > i.len {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+}
+
+func (i *concurrentDistributedIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *concurrentDistributedIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// IterStart creates an iterator over the elements that can be read when it's
+// created. Elements appended afterwards aren't iterated over
+func (s *ConcurrentDistributed[T]) IterStart() Iterator[T] {
+	return &concurrentDistributedIterator[T]{slice: s, len: s.Len(), index: -1}
+}
+
+// IterEnd creates an iterator pointed to the end of the elements that can be
+// read when it's created
+func (s *ConcurrentDistributed[T]) IterEnd() Iterator[T] {
+	len := s.Len()
+	return &concurrentDistributedIterator[T]{slice: s, len: len, index: len}
+}
+
+func (s *ConcurrentDistributed[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s *ConcurrentDistributed[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+// ToGoSlice copies the elements that can be read into a Go slice
+func (s *ConcurrentDistributed[T]) ToGoSlice() []T {
+	len := s.Len()
+	slice := make([]T, len, atLeast(1, len))
+	for i := range slice {
+		slice[i] = s.get(i)
+	}
+	return slice
+}
+
+// Snapshot copies the elements that can be read into a Distributed Slice,
+// with the same bucket capacity
+func (s *ConcurrentDistributed[T]) Snapshot() Slice[T] {
+	return EmptyDistributed[T](0, s.cap()).Append(s.ToGoSlice()...)
+}
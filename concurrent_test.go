@@ -0,0 +1,146 @@
+package slice
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentDistributed(t *testing.T) {
+	var s ConcurrentDistributed[int]
+	commonSliceLenTest(t, s.Snapshot(), 0)
+	assert.Equal(t, 0, s.Append(1, 2, 3))
+	assert.Equal(t, 3, s.AppendSlice(Wrap([]int{4, 5})))
+	assert.Equal(t, 5, s.Len())
+	assert.Equal(t, 4, s.Get(3))
+	assert.Panics(t, func() {
+		s.Get(5)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.Snapshot().ToGoSlice())
+
+	// Iterators shouldn't see elements appended after they're created
+	iter := s.IterStart()
+	s.Append(6)
+	var elems []int
+	for iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, elems)
+	assert.Panics(t, func() {
+		iter.Set(0)
+	})
+
+	iter = s.ReverseIterStart()
+	elems = nil
+	for iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, elems)
+
+	randIter := s.IterStart().(RandomAccessIterator[int])
+	randIter.Seek(4)
+	assert.Equal(t, 5, randIter.Get())
+	randIter.Advance(-2)
+	assert.Equal(t, 3, randIter.Get())
+}
+
+func TestConcurrentDistributed_Buckets(t *testing.T) {
+	// Small buckets, so the elements span many buckets and segments
+	s := EmptyConcurrentDistributed[int](3)
+	expected := make([]int, 1000)
+	for i := range expected {
+		expected[i] = i
+		s.Append(i)
+	}
+	assert.Equal(t, expected, s.ToGoSlice())
+	assert.Equal(t, expected, ConcurrentDistributedFrom(expected).ToGoSlice())
+}
+
+func TestConcurrentDistributed_Stress(t *testing.T) {
+	const writers = 200
+	const batches = 50
+	const batchLen = 3
+
+	s := EmptyConcurrentDistributed[int](7)
+	done := make(chan struct{})
+
+	// Readers should always see a prefix where every element has been written
+	var readers sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			last := 0
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				len := s.Len()
+				if len < last {
+					t.Errorf("length went from %d to %d", last, len)
+					return
+				}
+				last = len
+				iter := s.IterStart()
+				for iter.Next() {
+					if iter.Get() == 0 {
+						t.Error("read an element that wasn't written")
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	var writersWg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writersWg.Add(1)
+		go func(w int) {
+			defer writersWg.Done()
+			for b := 0; b < batches; b++ {
+				batch := make([]int, batchLen)
+				for k := range batch {
+					// Encode the writer, batch and position in the element
+					batch[k] = (w*batches+b)*batchLen + k + 1
+				}
+				s.Append(batch...)
+			}
+		}(w)
+	}
+	writersWg.Wait()
+	close(done)
+	readers.Wait()
+
+	elems := s.ToGoSlice()
+	assert.Equal(t, writers*batches*batchLen, len(elems))
+
+	// Each batch should be kept together, and each writer's batches should be
+	// in order
+	lastBatch := make(map[int]int)
+	for i := 0; i < len(elems); i += batchLen {
+		first := elems[i] - 1
+		assert.Equal(t, 0, first%batchLen)
+		for k := 1; k < batchLen; k++ {
+			assert.Equal(t, elems[i]+k, elems[i+k])
+		}
+		w, b := first/batchLen/batches, first/batchLen%batches
+		if prev, ok := lastBatch[w]; ok {
+			assert.Less(t, prev, b)
+		}
+		lastBatch[w] = b
+	}
+	assert.Equal(t, writers, len(lastBatch))
+}
+
+func BenchmarkConcurrentDistributed_Append(b *testing.B) {
+	s := EmptyConcurrentDistributed[int](0)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Append(1)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/bhollier/slice"
+	"github.com/bhollier/slice/slicetest"
+)
+
+func TestConformance(t *testing.T) {
+	for _, f := range slice.ValueFactories() {
+		f := f
+		t.Run(f.Name, func(t *testing.T) {
+			slicetest.RunConformance(t, f.From)
+		})
+	}
+}
+
+// BENCHMARKING
+
+func BenchmarkConformance(b *testing.B) {
+	for _, f := range slice.ValueFactories() {
+		f := f
+		b.Run(f.Name, func(b *testing.B) {
+			slicetest.RunBenchmarks(b, f.From)
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package slice
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// The state of a COW, which is shared by its copies
+type cowState[T any] struct {
+	slice Slice[T]
+	// Whether the slice's storage is shared with a snapshot, which is 1 if it
+	// is. It's atomic as a snapshot can be taken by readers, such as by
+	// DeepCopy while a Synchronized is read locked
+	shared uint32
+	// The buckets of a Distributed slice that are still shared with a
+	// snapshot, by the address of their first element
+	sharedBuckets map[*T]struct{}
+}
+
+// COW is a Slice type that wraps another Slice, and shares its storage with
+// snapshots of it until the first write, so Snapshot is O(1). Writing to a COW
+// or its snapshot copies the wrapped slice, except for a Distributed slice,
+// where only the buckets that are written to are copied. Like Synchronized,
+// the functions that modify a COW modify it in place and return the same COW,
+// so every copy of a COW sees the changes. Slice creates a new COW, which
+// shares the storage until the first write
+type COW[T any] struct {
+	state *cowState[T]
+}
+
+// CopyOnWrite creates a COW Slice that wraps the given slice. The given slice
+// shouldn't be used directly afterwards
+func CopyOnWrite[T any](s Slice[T]) Slice[T] {
+	return COW[T]{state: &cowState[T]{slice: s}}
+}
+
+// Snapshot creates a COW with the same elements as s, in O(1) time. Writing to
+// either s or the snapshot doesn't change the other
+func (s COW[T]) Snapshot() Slice[T] {
+	s.state.markShared()
+	return COW[T]{state: &cowState[T]{slice: s.state.slice, shared: 1}}
+}
+
+// Records that the slice's storage is shared with a snapshot
+func (st *cowState[T]) markShared() {
+	atomic.StoreUint32(&st.shared, 1)
+}
+
+// Gets whether the slice's storage is shared with a snapshot, and records
+// that it no longer will be, as the caller is about to copy it
+func (st *cowState[T]) takeShared() bool {
+	return atomic.SwapUint32(&st.shared, 0) == 1
+}
+
+// Gets the index of the bucket the element at the given index is in, if the
+// wrapped slice is a Distributed slice
+func (st *cowState[T]) bucketOf(i int) int {
+	if d, ok := st.slice.(Distributed[T]); ok && d.bucketCap > 0 {
+		return (i + d.start) / d.bucketCap
+	}
+	return 0
+}
+
+// Makes sure the wrapped slice can be written to, by copying it if it's
+// shared. For a Distributed slice, only the buckets from first to last
+// (inclusive) are copied. Returns whether any storage was copied
+func (st *cowState[T]) own(first, last int) bool {
+	d, ok := st.slice.(Distributed[T])
+	if !ok {
+		if !st.takeShared() {
+			return false
+		}
+		st.slice = st.slice.DeepCopy()
+		return true
+	}
+
+	copied := false
+	if st.takeShared() {
+		// Copy the array of buckets, and record that every bucket is shared
+		buckets := make([]bucket[T], len(d.buckets))
+		copy(buckets, d.buckets)
+		d.buckets = buckets
+		st.sharedBuckets = make(map[*T]struct{}, len(buckets))
+		for _, b := range buckets {
+			if len(b) > 0 {
+				st.sharedBuckets[&b[0]] = struct{}{}
+			}
+		}
+		copied = true
+	}
+
+	for b := atLeast(first, 0); b <= atMost(last, len(d.buckets)-1); b++ {
+		if len(d.buckets[b]) == 0 {
+			continue
+		}
+		if _, ok := st.sharedBuckets[&d.buckets[b][0]]; ok {
+			delete(st.sharedBuckets, &d.buckets[b][0])
+			bkt := make(bucket[T], len(d.buckets[b]))
+			copy(bkt, d.buckets[b])
+			d.buckets[b] = bkt
+			copied = true
+		}
+	}
+	st.slice = d
+	return copied
+}
+
+// Makes sure the elements between i and j (exclusive) can be replaced. For a
+// Distributed slice, this copies the same buckets Distributed.splice moves
+// elements in
+func (st *cowState[T]) ownRange(i, j int) {
+	if i < st.slice.Len()-j {
+		st.own(0, st.bucketOf(j))
+	} else {
+		st.own(st.bucketOf(i), st.bucketOf(st.slice.Len()))
+	}
+}
+
+// Gets the wrapped slice of elems, if it's a COW, so that appending a COW to
+// itself reads the elements before they're changed
+func unwrapCOW[T any](elems Slice[T]) Slice[T] {
+	if c, ok := elems.(COW[T]); ok {
+		return c.state.slice
+	}
+	return elems
+}
+
+func (s COW[T]) Append(elems ...T) Slice[T] {
+	end := s.state.bucketOf(s.state.slice.Len())
+	s.state.own(end, end)
+	s.state.slice = s.state.slice.Append(elems...)
+	return s
+}
+
+func (s COW[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	elems = unwrapCOW(elems)
+	end := s.state.bucketOf(s.state.slice.Len())
+	s.state.own(end, end)
+	s.state.slice = s.state.slice.AppendSlice(elems)
+	return s
+}
+
+func (s COW[T]) Prepend(elems ...T) Slice[T] {
+	s.state.own(0, 0)
+	s.state.slice = s.state.slice.Prepend(elems...)
+	return s
+}
+
+func (s COW[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	elems = unwrapCOW(elems)
+	s.state.own(0, 0)
+	s.state.slice = s.state.slice.PrependSlice(elems)
+	return s
+}
+
+// Slice creates a new COW from the elements between i and j (exclusive), in
+// the same time as slicing the wrapped slice. The new COW shares the storage
+// with s until either of them is written to
+func (s COW[T]) Slice(i, j int) Slice[T] {
+	// Slicing past the end of the slice grows it
+	if j > s.state.slice.Len() {
+		end := s.state.bucketOf(s.state.slice.Len())
+		s.state.own(end, end)
+	}
+	s.state.markShared()
+	return COW[T]{state: &cowState[T]{slice: s.state.slice.Slice(i, j), shared: 1}}
+}
+
+func (s COW[T]) Erase(i int) Slice[T] {
+	s.state.ownRange(i, i+1)
+	s.state.slice = s.state.slice.Erase(i)
+	return s
+}
+
+func (s COW[T]) EraseRange(i, j int) Slice[T] {
+	s.state.ownRange(i, j+1)
+	s.state.slice = s.state.slice.EraseRange(i, j)
+	return s
+}
+
+func (s COW[T]) Insert(i int, elems ...T) Slice[T] {
+	s.state.ownRange(i, i)
+	s.state.slice = s.state.slice.Insert(i, elems...)
+	return s
+}
+
+func (s COW[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	elems = unwrapCOW(elems)
+	s.state.ownRange(i, i)
+	s.state.slice = s.state.slice.InsertSlice(i, elems)
+	return s
+}
+
+func (s COW[T]) Replace(i, j int, elems ...T) Slice[T] {
+	s.state.ownRange(i, j)
+	s.state.slice = s.state.slice.Replace(i, j, elems...)
+	return s
+}
+
+func (s COW[T]) Get(i int) T {
+	return s.state.slice.Get(i)
+}
+
+func (s COW[T]) Set(i int, elem T) {
+	b := s.state.bucketOf(i)
+	s.state.own(b, b)
+	s.state.slice.Set(i, elem)
+}
+
+type cowIterator[T any] struct {
+	slice COW[T]
+	iter  Iterator[T]
+	index int
+}
+
+func (i *cowIterator[T]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *cowIterator[T]) Next() bool {
+	if i.iter.Next() {
+		i.index++
+		return true
+	}
+	return false
+}
+
+func (i *cowIterator[T]) HasPrev() bool {
+	return i.iter.HasPrev()
+}
+
+func (i *cowIterator[T]) Prev() bool {
+	if i.iter.Prev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *cowIterator[T]) Get() T {
+	return i.iter.Get()
+}
+
+// Set sets the element the iterator is pointed to. If the element is shared
+// with a snapshot, it's copied first, and the iterator moves to the copy
+func (i *cowIterator[T]) Set(elem T) {
+	b := i.slice.state.bucketOf(i.index)
+	if i.slice.state.own(b, b) {
+		index := i.index
+		i.iter, i.index = i.slice.state.slice.IterStart(), -1
+		i.seek(index)
+	}
+	i.iter.Set(elem)
+}
+
+// Moves the wrapped iterator to the given index, from wherever it is
+func (i *cowIterator[T]) seek(index int) {
+	if iter, ok := i.iter.(RandomAccessIterator[T]); ok {
+		iter.Seek(index)
+		i.index = index
+		return
+	}
+	// The ends can't be reached by walking, as Next and Prev stop at the first
+	// and last elements
+	switch index {
+	case -1:
+		i.iter, i.index = i.slice.state.slice.IterStart(), -1
+	case i.slice.state.slice.Len():
+		i.iter, i.index = i.slice.state.slice.IterEnd(), index
+	}
+	for i.index < index && i.Next() {
+	}
+	for i.index > index && i.Prev() {
+	}
+}
+
+func (i *cowIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+// Seek moves the iterator to the given index. If the wrapped slice's
+// iterators aren't RandomAccessIterators, this walks to the index
+func (i *cowIterator[T]) Seek(index int) {
+	if index < -1 || index > i.slice.state.slice.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.seek(index)
+}
+
+func (i *cowIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *cowIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+func (s COW[T]) IterStart() Iterator[T] {
+	return &cowIterator[T]{slice: s, iter: s.state.slice.IterStart(), index: -1}
+}
+
+func (s COW[T]) IterEnd() Iterator[T] {
+	return &cowIterator[T]{slice: s, iter: s.state.slice.IterEnd(), index: s.state.slice.Len()}
+}
+
+func (s COW[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s COW[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+// DeepCopy creates a Snapshot of the slice, in O(1) time
+func (s COW[T]) DeepCopy() Slice[T] {
+	return s.Snapshot()
+}
+
+func (s COW[T]) Len() int {
+	return s.state.slice.Len()
+}
+
+func (s COW[T]) Cap() int {
+	return s.state.slice.Cap()
+}
+
+func (s COW[T]) ToGoSlice() []T {
+	return s.state.slice.ToGoSlice()
+}
@@ -0,0 +1,180 @@
+package slice
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCOW_Iter(t *testing.T) {
+	commonSliceIterTest(t, CopyOnWrite(EmptyDistributed[int](0, 2)))
+	commonSliceIterTest(t, CopyOnWrite(DistributedFrom([]int{1})))
+	commonSliceIterTest(t, CopyOnWrite(DoublyFrom([]int{1, 2})))
+}
+
+func TestCOW_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, CopyOnWrite(EmptyDistributed[int](0, 2)))
+	commonSliceReverseIterTest(t, CopyOnWrite(DistributedFrom([]int{1})))
+	commonSliceReverseIterTest(t, CopyOnWrite(DoublyFrom([]int{1, 2})))
+}
+
+func TestCOW_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, CopyOnWrite(EmptyDistributed[int](0, 2)))
+	commonSliceRandomAccessIterTest(t, CopyOnWrite(DistributedFrom([]int{1})))
+	// Doubly iterators aren't random access, so the iterator walks
+	commonSliceRandomAccessIterTest(t, CopyOnWrite(DoublyFrom([]int{1, 2})))
+}
+
+// Counts how many buckets of a and b are different arrays
+func cowCopiedBuckets(a, b Slice[int]) int {
+	aBuckets := a.(COW[int]).state.slice.(Distributed[int]).buckets
+	bBuckets := b.(COW[int]).state.slice.(Distributed[int]).buckets
+	copied := 0
+	for i := range aBuckets {
+		if &aBuckets[i][0] != &bBuckets[i][0] {
+			copied++
+		}
+	}
+	return copied
+}
+
+func TestCOW_Snapshot(t *testing.T) {
+	elems := make([]int, 40)
+	for i := range elems {
+		elems[i] = i
+	}
+	s := CopyOnWrite(EmptyDistributed[int](0, 4).Append(elems...))
+	snap := s.(COW[int]).Snapshot()
+	assert.Equal(t, 0, cowCopiedBuckets(s, snap))
+
+	// Only the bucket that is written to should be copied
+	s.Set(5, -1)
+	assert.Equal(t, -1, s.Get(5))
+	assert.Equal(t, 5, snap.Get(5))
+	assert.Equal(t, 1, cowCopiedBuckets(s, snap))
+	s.Set(6, -1)
+	assert.Equal(t, 1, cowCopiedBuckets(s, snap))
+	s.Set(38, -1)
+	assert.Equal(t, 2, cowCopiedBuckets(s, snap))
+	assert.Equal(t, elems, snap.ToGoSlice())
+
+	// Writing to the snapshot shouldn't change the slice
+	snap.Set(0, -1)
+	assert.Equal(t, 0, s.Get(0))
+	assert.Equal(t, -1, snap.Get(0))
+
+	// Appending to a slice of a full bucket shouldn't overwrite the rest of it
+	s = CopyOnWrite(EmptyDistributed[int](0, 4).Append(elems...))
+	sliced := s.Slice(0, 2)
+	sliced.Append(-1)
+	assert.Equal(t, elems, s.ToGoSlice())
+	assert.Equal(t, []int{0, 1, -1}, sliced.ToGoSlice())
+
+	// Setting through an iterator should copy the bucket
+	snap = s.DeepCopy()
+	iter := s.IterStart()
+	for iter.Next() {
+		iter.Set(iter.Get() * 2)
+	}
+	assert.Equal(t, elems, snap.ToGoSlice())
+	assert.Equal(t, Map(snap, func(elem int) int {
+		return elem * 2
+	}).ToGoSlice(), s.ToGoSlice())
+
+	// Every copy of the slice should see the changes
+	cpy := s
+	s.Append(100)
+	assert.Equal(t, 100, cpy.Get(40))
+}
+
+func TestCOW_Edits(t *testing.T) {
+	seed := time.Now().UnixNano()
+	r := rand.New(rand.NewSource(seed))
+	t.Logf("seed: %d", seed)
+
+	for _, s := range []Slice[int]{
+		CopyOnWrite(EmptyDistributed[int](0, 3)),
+		CopyOnWrite(EmptySlice[int](0, 0)),
+		CopyOnWrite(EmptyDoubly[int]()),
+	} {
+		var expected []int
+		var snaps []Slice[int]
+		var snapElems [][]int
+		for op := 0; op < 2000; op++ {
+			if op%50 == 0 {
+				snaps = append(snaps, s.(COW[int]).Snapshot())
+				snapElems = append(snapElems, append([]int{}, expected...))
+			}
+
+			elem := r.Int()
+			switch i := r.Intn(len(expected) + 1); r.Intn(6) {
+			case 0:
+				s = s.Append(elem)
+				expected = append(expected, elem)
+			case 1:
+				s = s.Prepend(elem)
+				expected = append([]int{elem}, expected...)
+			case 2:
+				s = s.Insert(i, elem, elem)
+				expected = append(expected[:i], append([]int{elem, elem}, expected[i:]...)...)
+			case 3:
+				if i < len(expected) {
+					s = s.Erase(i)
+					expected = append(expected[:i], expected[i+1:]...)
+				}
+			case 4:
+				if i < len(expected) {
+					s.Set(i, elem)
+					expected[i] = elem
+				}
+			case 5:
+				j := i + r.Intn(len(expected)-i+1)
+				s = s.Replace(i, j, elem)
+				expected = append(expected[:i], append([]int{elem}, expected[j:]...)...)
+			}
+		}
+
+		assert.Equal(t, expected, s.ToGoSlice())
+		for k, snap := range snaps {
+			assert.Equal(t, snapElems[k], snap.ToGoSlice())
+		}
+	}
+}
+
+func TestCOW_Synchronized(t *testing.T) {
+	// Iterating over a Synchronized takes a snapshot of the COW while only
+	// read locked, so many goroutines can snapshot it at once. Run with -race
+	s := Synchronize(CopyOnWrite(EmptyDistributed[int](0, 4).Append(1, 2, 3, 4, 5)))
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				iter := s.IterStart()
+				for iter.Next() {
+				}
+				if g == 0 {
+					s.Set(0, i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{99, 2, 3, 4, 5}, s.ToGoSlice())
+}
+
+// BENCHMARKING
+
+func BenchmarkCOW_Snapshot(b *testing.B) {
+	s := CopyOnWrite(EmptyDistributed[int](0, 0).Append(make([]int, 100000)...))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.(COW[int]).Snapshot()
+		s.Set(i%s.Len(), i)
+	}
+}
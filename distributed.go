@@ -111,6 +111,13 @@ func (s Distributed[T]) Prepend(elems ...T) Slice[T] {
 }
 
 func (s Distributed[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	// If there's nothing to prepend. Otherwise an empty slice would get a
+	// bucket with its start at the end, which has no elements before a bucket
+	// added by Append
+	if elems.Len() == 0 {
+		return s
+	}
+
 	// If the slice is empty
 	if len(s.buckets) == 0 {
 		// Add a node
@@ -149,8 +156,8 @@ func (s Distributed[T]) Slice(i, j int) Slice[T] {
 	if i < 0 {
 		panic(fmt.Sprintf("index [%d] out of range", i))
 	}
-	if j < 0 {
-		panic(fmt.Sprintf("index [%d] out of range", j))
+	if j < i {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d]", i, j))
 	}
 
 	// Calculate the real i and j index
@@ -163,20 +170,145 @@ func (s Distributed[T]) Slice(i, j int) Slice[T] {
 	// Reslice the buckets slice
 	s.buckets = s.buckets[bucketsStart:bucketsEnd]
 
-	// Set the start and end point
-	s.start = iIndex % s.bucketCap
-	s.end = ((jIndex - 1) % s.bucketCap) + 1
+	// Set the start and end point. If there are no buckets left, the slice is
+	// empty, so they're both 0
+	if bucketsStart == bucketsEnd {
+		s.start, s.end = 0, 0
+	} else {
+		s.start = iIndex % s.bucketCap
+		s.end = ((jIndex - 1) % s.bucketCap) + 1
+	}
 
 	// Return the slice
 	return s
 }
 
+// Copies n elements from index src to index dst, one bucket at a time. The
+// ranges are allowed to overlap
+func (s Distributed[T]) copyWithin(dst, src, n int) {
+	// If the elements are being moved backwards
+	if dst < src {
+		// Copy from the start, so that no element is overwritten before it's copied
+		for n > 0 {
+			// Calculate the real indices
+			dstIndex, srcIndex := dst+s.start, src+s.start
+			dstBucket := s.buckets[dstIndex/s.bucketCap][dstIndex%s.bucketCap:]
+			srcBucket := s.buckets[srcIndex/s.bucketCap][srcIndex%s.bucketCap:]
+			// Copy as much as possible without leaving either bucket
+			copied := copy(dstBucket[:atMost(n, len(dstBucket))], srcBucket)
+			dst, src, n = dst+copied, src+copied, n-copied
+		}
+
+		// Otherwise, if the elements are being moved forwards
+	} else if dst > src {
+		// Copy from the end, so that no element is overwritten before it's copied
+		for n > 0 {
+			// Calculate the real (exclusive) end indices
+			dstIndex, srcIndex := dst+n+s.start-1, src+n+s.start-1
+			dstBucket := s.buckets[dstIndex/s.bucketCap][:dstIndex%s.bucketCap+1]
+			srcBucket := s.buckets[srcIndex/s.bucketCap][:srcIndex%s.bucketCap+1]
+			// Copy as much as possible without leaving either bucket
+			count := atMost(n, atMost(len(dstBucket), len(srcBucket)))
+			copy(dstBucket[len(dstBucket)-count:], srcBucket[len(srcBucket)-count:])
+			n -= count
+		}
+	}
+}
+
+// Replaces the elements between i and j (exclusive) with the given elements.
+// Only the elements between the range and the closest end of the slice are
+// moved
+func (s Distributed[T]) splice(i, j int, elems Slice[T]) Slice[T] {
+	length := s.Len()
+	checkRange(i, j, length)
+
+	// Calculate how much the slice needs to grow (or shrink) by
+	diff := elems.Len() - (j - i)
+
+	// If the range is closer to the start of the slice
+	if i < length-j {
+		if diff > 0 {
+			// Grow the start, then move the elements before the range backwards
+			s = s.Prepend(make([]T, diff)...).(Distributed[T])
+			s.copyWithin(0, diff, i)
+		} else if diff < 0 {
+			// Move the elements before the range forwards, then shrink the start
+			s.copyWithin(-diff, 0, i)
+			s = s.Slice(-diff, length).(Distributed[T])
+		}
+
+		// Otherwise, if the range is closer to the end of the slice
+	} else {
+		if diff > 0 {
+			// Grow the end, then move the elements after the range forwards
+			s = s.Append(make([]T, diff)...).(Distributed[T])
+			s.copyWithin(j+diff, j, length-j)
+		} else if diff < 0 {
+			// Move the elements after the range backwards, then shrink the end
+			s.copyWithin(j+diff, j, length-j)
+			s = s.Slice(0, length+diff).(Distributed[T])
+		}
+	}
+
+	// Copy the elements in
+	iter := elems.IterStart()
+	for iter.Next() {
+		s.Set(i, iter.Get())
+		i++
+	}
+
+	return s
+}
+
+func (s Distributed[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, Wrapper[T]{})
+}
+
+func (s Distributed[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, Wrapper[T]{})
+}
+
+func (s Distributed[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, Wrap(elems))
+}
+
+func (s Distributed[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s Distributed[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, Wrap(elems))
+}
+
+// Gets the part of the bucket at the given index that is inside the slice
+func (s Distributed[T]) bucket(i int) bucket[T] {
+	// Set the start and end point of the bucket
+	start, end := 0, s.bucketCap
+	// If this is the first bucket
+	if i == 0 {
+		// Set the start as the start of the slice
+		start = s.start
+	}
+	// If this is the last bucket
+	if i == len(s.buckets)-1 {
+		// Set the end as the end of the slice
+		end = s.end
+	}
+	return s.buckets[i][start:end]
+}
+
 func (s Distributed[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
 	index := i + s.start
 	return s.buckets[index/s.bucketCap][index%s.bucketCap]
 }
 
 func (s Distributed[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
 	index := i + s.start
 	s.buckets[index/s.bucketCap][index%s.bucketCap] = elem
 }
@@ -204,8 +336,10 @@ func (i *distributedIterator[T]) HasNext() bool {
 	// There is another element if the next index is still inside the bounds of
 	// the bucket
 	return i.index+1 < bucketCap ||
-		// Or if there is a next bucket
-		i.bucketIndex+1 < len(i.slice.buckets)
+		// Or if there is a next bucket that isn't the last bucket
+		i.bucketIndex+2 < len(i.slice.buckets) ||
+		// Or if the next bucket is the last bucket, and it isn't empty
+		(i.bucketIndex+2 == len(i.slice.buckets) && i.slice.end > 0)
 }
 
 func (i *distributedIterator[T]) Next() bool {
@@ -273,6 +407,77 @@ func (i *distributedIterator[T]) Set(elem T) {
 	i.slice.buckets[i.bucketIndex][i.index] = elem
 }
 
+func (i *distributedIterator[T]) Advance(n int) {
+	i.Seek(i.Index() + n)
+}
+
+func (i *distributedIterator[T]) Seek(index int) {
+	length := i.slice.Len()
+	if index < -1 || index > length {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+
+	// If the index is the end, point to the end of the last bucket (which could
+	// be full)
+	if index == length {
+		i.bucketIndex = atLeast(0, len(i.slice.buckets)-1)
+		i.index = i.slice.end
+		return
+	}
+
+	// Calculate the real index
+	realIndex := index + i.slice.start
+	i.bucketIndex = realIndex / i.slice.bucketCap
+	i.index = realIndex % i.slice.bucketCap
+}
+
+func (i *distributedIterator[T]) Index() int {
+	return i.bucketIndex*i.slice.bucketCap + i.index - i.slice.start
+}
+
+func (i *distributedIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.Index()
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to. Like Distributed.Insert, only the elements between the iterator
+// and the closest end of the slice are moved
+func (i *distributedIterator[T]) InsertBefore(elem T) {
+	index := i.Index()
+	if index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.slice = i.slice.Insert(index, elem).(Distributed[T])
+	i.Seek(index + 1)
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to. Like Distributed.Insert, only the elements between the iterator
+// and the closest end of the slice are moved
+func (i *distributedIterator[T]) InsertAfter(elem T) {
+	index := i.Index()
+	if index >= i.slice.Len() {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.slice = i.slice.Insert(index+1, elem).(Distributed[T])
+	i.Seek(index)
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element. Like Distributed.Erase, only the elements
+// between the iterator and the closest end of the slice are moved
+func (i *distributedIterator[T]) Remove() {
+	index := i.Index()
+	i.slice = i.slice.Erase(index).(Distributed[T])
+	i.Seek(index - 1)
+}
+
+// Slice gets the slice the iterator is iterating over, including the changes
+// made by the iterator
+func (i *distributedIterator[T]) Slice() Slice[T] {
+	return i.slice
+}
+
 func (s Distributed[T]) IterStart() Iterator[T] {
 	return &distributedIterator[T]{
 		slice:       s,
@@ -284,7 +489,7 @@ func (s Distributed[T]) IterStart() Iterator[T] {
 func (s Distributed[T]) IterEnd() Iterator[T] {
 	return &distributedIterator[T]{
 		slice:       s,
-		bucketIndex: len(s.buckets) - 1,
+		bucketIndex: atLeast(0, len(s.buckets)-1),
 		index:       s.end,
 	}
 }
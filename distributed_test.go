@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDistributed_Append(t *testing.T) {
@@ -30,6 +32,18 @@ func TestDistributed_Slice(t *testing.T) {
 	commonSliceSliceTest(t, DistributedFrom([]int{1, 2}))
 }
 
+func TestDistributed_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyDistributed[int](0, 2))
+	commonSliceEraseTest(t, DistributedFrom([]int{1}))
+	commonSliceEraseTest(t, DistributedFrom([]int{1, 2}))
+}
+
+func TestDistributed_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyDistributed[int](0, 2))
+	commonSliceReplaceTest(t, DistributedFrom([]int{1}))
+	commonSliceReplaceTest(t, DistributedFrom([]int{1, 2}))
+}
+
 func TestDistributed_Iter(t *testing.T) {
 	commonSliceIterTest(t, EmptyDistributed[int](0, 2))
 	commonSliceIterTest(t, DistributedFrom([]int{1}))
@@ -42,6 +56,62 @@ func TestDistributed_ReverseIter(t *testing.T) {
 	commonSliceReverseIterTest(t, DistributedFrom([]int{1, 2}))
 }
 
+func TestDistributed_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyDistributed[int](0, 2))
+	commonSliceRandomAccessIterTest(t, DistributedFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, DistributedFrom([]int{1, 2}))
+}
+
+func TestDistributed_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyDistributed[int](0, 2))
+	commonSliceMutableIterTest(t, DistributedFrom([]int{1}))
+	commonSliceMutableIterTest(t, DistributedFrom([]int{1, 2}))
+}
+
+func TestDistributed_PrependNothing(t *testing.T) {
+	// Prepending nothing onto an empty slice shouldn't leave an empty bucket
+	// before the elements appended after it
+	s := EmptyDistributed[int](0, 2).Prepend().Append(1, 2, 3)
+	assert.Equal(t, 3, s.Len())
+	commonSliceReverseIterTest(t, s)
+	iter := s.IterEnd()
+	for i := 3; i > 0; i-- {
+		assert.True(t, iter.Prev())
+		assert.Equal(t, i, iter.Get())
+	}
+	assert.False(t, iter.HasPrev())
+}
+
+func TestDistributed_OutOfRange(t *testing.T) {
+	// The spare room in the buckets shouldn't be accessible
+	s := EmptyDistributed[int](0, 4).Prepend(1).Append(2)
+	assert.Panics(t, func() {
+		s.Get(-1)
+	})
+	assert.Panics(t, func() {
+		s.Get(2)
+	})
+	assert.Panics(t, func() {
+		s.Set(2, 3)
+	})
+	assert.Panics(t, func() {
+		s.Slice(2, 1)
+	})
+}
+
+func TestDistributed_SliceToEmpty(t *testing.T) {
+	// Slicing to nothing at a bucket boundary leaves no buckets, so the
+	// iterators should still be at index 0
+	for _, s := range []Slice[int]{
+		EmptyDistributed[int](0, 1).Append(1).Slice(0, 0),
+		EmptyDistributed[int](0, 2).Append(1, 2, 3).Slice(2, 2),
+	} {
+		commonSliceLenTest(t, s, 0)
+		assert.Equal(t, 0, s.IterEnd().(RandomAccessIterator[int]).Index())
+		assert.Equal(t, []int{4}, s.Insert(0, 4).ToGoSlice())
+	}
+}
+
 // BENCHMARKING
 
 func BenchmarkDistributed_Append(b *testing.B) {
@@ -9,22 +9,41 @@ type doublyNode[T any] struct {
 }
 
 func (n *doublyNode[T]) Next() LinkedListNode[T] {
+	if n == nil {
+		return nil
+	}
 	return n.next
 }
 
 func (n *doublyNode[T]) Prev() LinkedListNode[T] {
+	if n == nil {
+		return nil
+	}
 	return n.prev
 }
 
+// Get gets the node's element. A list that was edited from can have fewer
+// nodes than its length, so a missing (nil) node gets the zero value
 func (n *doublyNode[T]) Get() T {
+	if n == nil {
+		var zero T
+		return zero
+	}
 	return n.elem
 }
 
+// Set sets the node's element, unless the node is missing (nil)
 func (n *doublyNode[T]) Set(elem T) {
-	n.elem = elem
+	if n != nil {
+		n.elem = elem
+	}
 }
 
-// Doubly is a Slice type, implemented as a doubly linked list
+// Doubly is a Slice type, implemented as a doubly linked list. Slices of a
+// list share its nodes, so editing a slice can change the other slices of the
+// list. If editing a slice unlinks the nodes another slice was using, the other
+// slice stays safe to read, but the elements it can no longer reach are the
+// zero value
 type Doubly[T any] struct {
 	len   int
 	start *doublyNode[T]
@@ -206,6 +225,99 @@ func (s Doubly[T]) Slice(i, j int) Slice[T] {
 	return s
 }
 
+// Replaces the nodes between i and j (exclusive) with the given linked list,
+// reusing the nodes of both lists
+func (s Doubly[T]) splice(i, j int, elems Doubly[T]) Doubly[T] {
+	checkRange(i, j, s.len)
+
+	// Erasing the end of the list just slices it, so the erased nodes stay
+	// linked for the slices still using them
+	if elems.len == 0 && j == s.len {
+		return s.Slice(0, i).(Doubly[T])
+	}
+
+	// Find the nodes either side of the range. If the list is a slice of a
+	// larger list, these can be outside of the list
+	var prev, after *doublyNode[T]
+	if i > 0 {
+		prev = s.node(i - 1)
+		after = prev.next
+	} else if s.start != nil {
+		prev = s.start.prev
+		after = s.start
+	}
+	// Skip over the nodes being removed, to find the node after the range
+	for k := i; k < j; k++ {
+		after = after.next
+	}
+
+	// Work out what the nodes either side of the range should be connected to
+	first, last := elems.start, elems.end
+	if elems.len == 0 {
+		first, last = after, prev
+	}
+
+	// Connect the nodes
+	if prev != nil {
+		prev.next = first
+	}
+	if after != nil {
+		after.prev = last
+	}
+	if elems.len > 0 {
+		elems.start.prev = prev
+		elems.end.next = after
+	}
+
+	// Calculate the new length
+	newLen := s.len - (j - i) + elems.len
+	// If the list is now empty
+	if newLen == 0 {
+		return Doubly[T]{}
+	}
+
+	// If the range was at the start or the end, update the start or end
+	if i == 0 {
+		s.start = first
+	}
+	if j == s.len {
+		s.end = last
+	}
+
+	// Set the length
+	s.len = newLen
+
+	return s
+}
+
+func (s Doubly[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, Doubly[T]{})
+}
+
+func (s Doubly[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, Doubly[T]{})
+}
+
+func (s Doubly[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, DoublyFrom(elems).(Doubly[T]))
+}
+
+func (s Doubly[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	// Try to convert the elements slice to a linked list
+	list, ok := elems.(Doubly[T])
+	// If it isn't a linked list
+	if !ok {
+		// Convert it to a linked list
+		list = DoublyFrom(elems.ToGoSlice()).(Doubly[T])
+	}
+
+	return s.splice(i, i, list)
+}
+
+func (s Doubly[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, DoublyFrom(elems).(Doubly[T]))
+}
+
 func (s Doubly[T]) Get(i int) T {
 	return s.Node(i).Get()
 }
@@ -215,30 +327,42 @@ func (s Doubly[T]) Set(i int, elem T) {
 }
 
 type doublyIterator[T any] struct {
+	list  Doubly[T]
 	node  *doublyNode[T]
-	start *doublyNode[T]
-	end   *doublyNode[T]
+	index int
 }
 
 func (i *doublyIterator[T]) HasNext() bool {
-	return i.node != i.end
+	return i.index+1 < i.list.len
 }
 
 func (i *doublyIterator[T]) Next() bool {
 	if i.HasNext() {
-		i.node = i.node.next
+		// If the iterator is before the start, go to the start
+		if i.index == -1 {
+			i.node = i.list.start
+		} else if i.node != nil {
+			i.node = i.node.next
+		}
+		i.index++
 		return true
 	}
 	return false
 }
 
 func (i *doublyIterator[T]) HasPrev() bool {
-	return i.node != i.start
+	return i.index > 0
 }
 
 func (i *doublyIterator[T]) Prev() bool {
 	if i.HasPrev() {
-		i.node = i.node.prev
+		// If the iterator is after the end, go to the end
+		if i.index == i.list.len {
+			i.node = i.list.end
+		} else if i.node != nil {
+			i.node = i.node.prev
+		}
+		i.index--
 		return true
 	}
 	return false
@@ -256,28 +380,180 @@ func (i *doublyIterator[T]) Set(elem T) {
 	i.Node().Set(elem)
 }
 
-func (s Doubly[T]) IterStart() Iterator[T] {
-	if s.Len() == 0 {
-		return &doublyIterator[T]{}
+// Advance moves the iterator n elements, by walking the list
+func (i *doublyIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+// Seek moves the iterator to the given index, by walking the list from
+// whichever of the iterator, the start or the end is closest
+func (i *doublyIterator[T]) Seek(index int) {
+	if index < -1 || index > i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+
+	// If the index is before or after the list, there is no node
+	if index == -1 || index == i.list.len {
+		i.node = nil
+		i.index = index
+		return
+	}
+
+	// Calculate the distance to the index from the iterator. If the iterator
+	// isn't pointed to a node, it can't be walked from, so the distance is
+	// further than the start or end can be
+	distance := i.list.len
+	if i.node != nil {
+		distance = index - i.index
+		if distance < 0 {
+			distance = -distance
+		}
+	}
+
+	// Start from whichever point is closest
+	fromStart, fromEnd := index, i.list.len-1-index
+	if fromStart <= fromEnd && fromStart < distance {
+		i.node = i.list.start
+		i.index = 0
+	} else if fromEnd < distance {
+		i.node = i.list.end
+		i.index = i.list.len - 1
+	}
+
+	for ; i.index < index && i.node != nil; i.index++ {
+		i.node = i.node.next
 	}
-	return &doublyIterator[T]{
-		// Set the node as a new one that is one element out of bounds
-		node:  &doublyNode[T]{next: s.start},
-		start: s.start,
-		end:   s.end,
+	for ; i.index > index && i.node != nil; i.index-- {
+		i.node = i.node.prev
 	}
+	i.index = index
 }
 
-func (s Doubly[T]) IterEnd() Iterator[T] {
-	if s.Len() == 0 {
-		return &doublyIterator[T]{}
+func (i *doublyIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *doublyIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// Connects the given node between prev and next, either of which can be nil
+func linkDoubly[T any](prev, node, next *doublyNode[T]) {
+	node.prev = prev
+	node.next = next
+	if prev != nil {
+		prev.next = node
+	}
+	if next != nil {
+		next.prev = node
+	}
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to, in O(1) time
+func (i *doublyIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+
+	// Find the nodes either side of the new node. If the list is part of a
+	// larger list, these can be outside of the list
+	var prev, next *doublyNode[T]
+	if i.index < i.list.len {
+		prev, next = i.node.prev, i.node
+	} else if i.list.end != nil {
+		prev, next = i.list.end, i.list.end.next
 	}
-	return &doublyIterator[T]{
-		// Set the node as a new one that is one element out of bounds
-		node:  &doublyNode[T]{prev: s.end},
-		start: s.start,
-		end:   s.end,
+
+	node := &doublyNode[T]{elem: elem}
+	linkDoubly(prev, node, next)
+	if i.index == 0 {
+		i.list.start = node
+	}
+	if i.index == i.list.len {
+		i.list.end = node
+	}
+
+	i.index++
+	i.list.len++
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to, in O(1) time
+func (i *doublyIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.list.len {
+		panic("slice: can't insert after the end of the slice")
 	}
+
+	// Find the nodes either side of the new node. If the list is part of a
+	// larger list, these can be outside of the list
+	var prev, next *doublyNode[T]
+	if i.index >= 0 {
+		prev, next = i.node, i.node.next
+	} else if i.list.start != nil {
+		prev, next = i.list.start.prev, i.list.start
+	}
+
+	node := &doublyNode[T]{elem: elem}
+	linkDoubly(prev, node, next)
+	if i.index == -1 {
+		i.list.start = node
+	}
+	if i.index == i.list.len-1 {
+		i.list.end = node
+	}
+
+	i.list.len++
+}
+
+// Remove removes the element the iterator is pointed to in O(1) time, then
+// moves the iterator to the previous element, so that Next moves to the element
+// after the removed one
+func (i *doublyIterator[T]) Remove() {
+	if i.index < 0 || i.index >= i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", i.index))
+	}
+
+	// Unlink the node
+	prev, next := i.node.prev, i.node.next
+	if prev != nil {
+		prev.next = next
+	}
+	if next != nil {
+		next.prev = prev
+	}
+	if i.index == 0 {
+		i.list.start = next
+	}
+	if i.index == i.list.len-1 {
+		i.list.end = prev
+	}
+	i.list.len--
+	if i.list.len == 0 {
+		i.list = Doubly[T]{}
+	}
+
+	// Move back to the previous node
+	i.index--
+	if i.index >= 0 {
+		i.node = prev
+	} else {
+		i.node = nil
+	}
+}
+
+// Slice gets the list the iterator is iterating over, including the changes
+// made by the iterator
+func (i *doublyIterator[T]) Slice() Slice[T] {
+	return i.list
+}
+
+func (s Doubly[T]) IterStart() Iterator[T] {
+	return &doublyIterator[T]{list: s, index: -1}
+}
+
+func (s Doubly[T]) IterEnd() Iterator[T] {
+	return &doublyIterator[T]{list: s, index: s.len}
 }
 
 func (s Doubly[T]) ReverseIterStart() Iterator[T] {
@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDoubly_Append(t *testing.T) {
@@ -30,6 +32,18 @@ func TestDoubly_Slice(t *testing.T) {
 	commonSliceSliceTest(t, DoublyFrom([]int{1, 2}))
 }
 
+func TestDoubly_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyDoubly[int]())
+	commonSliceEraseTest(t, DoublyFrom([]int{1}))
+	commonSliceEraseTest(t, DoublyFrom([]int{1, 2}))
+}
+
+func TestDoubly_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyDoubly[int]())
+	commonSliceReplaceTest(t, DoublyFrom([]int{1}))
+	commonSliceReplaceTest(t, DoublyFrom([]int{1, 2}))
+}
+
 func TestDoubly_Iter(t *testing.T) {
 	commonSliceIterTest(t, EmptyDoubly[int]())
 	commonSliceIterTest(t, DoublyFrom([]int{1}))
@@ -42,6 +56,82 @@ func TestDoubly_ReverseIter(t *testing.T) {
 	commonSliceReverseIterTest(t, DoublyFrom([]int{1, 2}))
 }
 
+func TestDoubly_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyDoubly[int]())
+	commonSliceRandomAccessIterTest(t, DoublyFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, DoublyFrom([]int{1, 2}))
+}
+
+func TestDoubly_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyDoubly[int]())
+	commonSliceMutableIterTest(t, DoublyFrom([]int{1}))
+	commonSliceMutableIterTest(t, DoublyFrom([]int{1, 2}))
+}
+
+func TestDoubly_Seek(t *testing.T) {
+	elems := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	// Seeking from before the start should walk from the end if it's closer.
+	// The links out of the start are cut, so walking from it would get the
+	// wrong element
+	s := DoublyFrom(elems).(Doubly[int])
+	s.start.next = nil
+	iter := s.IterStart().(RandomAccessIterator[int])
+	assert.NotPanics(t, func() {
+		iter.Seek(6)
+	})
+	assert.Equal(t, 6, iter.Get())
+
+	// Seeking from after the end should walk from the start if it's closer
+	s = DoublyFrom(elems).(Doubly[int])
+	s.end.prev = nil
+	iter = s.IterEnd().(RandomAccessIterator[int])
+	assert.NotPanics(t, func() {
+		iter.Seek(1)
+	})
+	assert.Equal(t, 1, iter.Get())
+
+	// Seeking from a node should walk from it if it's closest
+	s = DoublyFrom(elems).(Doubly[int])
+	iter = s.IterStart().(RandomAccessIterator[int])
+	iter.Seek(4)
+	s.start.next = nil
+	s.end.prev = nil
+	assert.NotPanics(t, func() {
+		iter.Seek(3)
+		iter.Seek(5)
+	})
+	assert.Equal(t, 5, iter.Get())
+}
+
+func TestDoubly_EditedFrom(t *testing.T) {
+	// Erasing the end should leave the list that was edited as it was
+	s := DoublyFrom([]int{1, 2, 3})
+	erased := s.Erase(2)
+	assert.Equal(t, []int{1, 2}, erased.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+	erased = s.EraseRange(1, 2)
+	assert.Equal(t, []int{1}, erased.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+
+	// Erasing the middle unlinks nodes the list was using. Walking the list
+	// forwards or backwards runs out of nodes at different places, but the
+	// elements that can't be reached should be read as the zero value
+	erased = s.Erase(1)
+	assert.Equal(t, []int{1, 3}, erased.ToGoSlice())
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{1, 3, 0}, s.ToGoSlice())
+	assert.Equal(t, []int{3, 1, 0}, collectPrev(s.IterEnd()))
+	assert.NotPanics(t, func() {
+		iter := s.IterStart().(RandomAccessIterator[int])
+		for k := 0; k < s.Len(); k++ {
+			s.Get(k)
+			iter.Seek(k)
+			iter.Get()
+		}
+	})
+}
+
 // BENCHMARKING
 
 func BenchmarkDoubly_Append(b *testing.B) {
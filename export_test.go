@@ -0,0 +1,20 @@
+package slice
+
+// A sliceFactory for the tests in package slice_test, which can't import
+// package slice's test files
+type ExportedFactory struct {
+	Name string
+	From func(elems []int) Slice[int]
+}
+
+// Gets the factories of the Slice types that aren't shared handles, which are
+// the types slicetest.RunConformance can test
+func ValueFactories() []ExportedFactory {
+	var factories []ExportedFactory
+	for _, f := range sliceFactories {
+		if !f.handle {
+			factories = append(factories, ExportedFactory{f.name, f.from})
+		}
+	}
+	return factories
+}
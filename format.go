@@ -0,0 +1,267 @@
+package slice
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// Formats the slice for fmt. %v and the other verbs print the elements like a
+// Go slice, %+v also prints the type, length and capacity, and %#v prints
+// goSyntax
+func formatSlice[T any](f fmt.State, verb rune, s Slice[T], goSyntax func() string) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, goSyntax())
+		return
+	}
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "%T(len=%d cap=%d)", s, s.Len(), s.Cap())
+	}
+
+	// Format each element with the same verb and flags. The # flag has been
+	// handled for %v
+	format := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format = append(format, byte(flag))
+		}
+	}
+	if width, ok := f.Width(); ok {
+		format = strconv.AppendInt(format, int64(width), 10)
+	}
+	if prec, ok := f.Precision(); ok {
+		format = append(format, '.')
+		format = strconv.AppendInt(format, int64(prec), 10)
+	}
+	format = append(format, string(verb)...)
+
+	io.WriteString(f, "[")
+	iter := s.IterStart()
+	for i := 0; iter.Next(); i++ {
+		if i > 0 {
+			io.WriteString(f, " ")
+		}
+		fmt.Fprintf(f, string(format), iter.Get())
+	}
+	io.WriteString(f, "]")
+}
+
+// Gets the name of T, as it would be written in Go
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// Gets the elements of the slice as a Go slice literal
+func goSliceSyntax[T any](s Slice[T]) string {
+	return fmt.Sprintf("%#v", s.ToGoSlice())
+}
+
+// Format implements fmt.Formatter, printing the slice like a Go slice
+func (s Wrapper[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the slice like a Go slice
+func (s Wrapper[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice
+func (s Wrapper[T]) GoString() string {
+	return fmt.Sprintf("slice.Wrap(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the slice like a Go slice
+func (s Distributed[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the slice like a Go slice
+func (s Distributed[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice, with the same bucket
+// capacity
+func (s Distributed[T]) GoString() string {
+	return fmt.Sprintf("slice.EmptyDistributed[%s](0, %d).Append(%s...)",
+		typeName[T](), s.bucketCap, goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the list like a Go slice
+func (s Singly[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the list like a Go slice
+func (s Singly[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the list
+func (s Singly[T]) GoString() string {
+	return fmt.Sprintf("slice.SinglyFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the list like a Go slice
+func (s Doubly[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the list like a Go slice
+func (s Doubly[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the list
+func (s Doubly[T]) GoString() string {
+	return fmt.Sprintf("slice.DoublyFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the slice like a Go slice
+func (s Persistent[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the slice like a Go slice
+func (s Persistent[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice
+func (s Persistent[T]) GoString() string {
+	return fmt.Sprintf("slice.PersistentFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the rope like a Go slice
+func (s Rope[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the rope like a Go slice
+func (s Rope[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the rope
+func (s Rope[T]) GoString() string {
+	return fmt.Sprintf("slice.RopeFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the ring like a Go slice, from its
+// first element
+func (s Ring[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the ring like a Go slice
+func (s Ring[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the ring, with the same capacity
+// and mode
+func (s Ring[T]) GoString() string {
+	mode := "slice.RingOverwrite"
+	if s.mode == RingReject {
+		mode = "slice.RingReject"
+	}
+	return fmt.Sprintf("slice.RingFrom(%s, %d, %s)", goSliceSyntax[T](s), len(s.buf), mode)
+}
+
+// Format implements fmt.Formatter, printing the buffer like a Go slice
+func (s GapBuffer[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the buffer like a Go slice
+func (s GapBuffer[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the buffer
+func (s GapBuffer[T]) GoString() string {
+	return fmt.Sprintf("slice.GapBufferFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the list like a Go slice
+func (s SkipList[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the list like a Go slice
+func (s SkipList[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the list
+func (s SkipList[T]) GoString() string {
+	return fmt.Sprintf("slice.SkipListFrom(%s)", goSliceSyntax[T](s))
+}
+
+// Format implements fmt.Formatter, printing the decoded records like a Go
+// slice
+func (s RecordSlice[T]) Format(f fmt.State, verb rune) {
+	formatSlice[T](f, verb, s, s.GoString)
+}
+
+// String prints the decoded records like a Go slice
+func (s RecordSlice[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice from a copy of its
+// bytes, with the same Codec
+func (s RecordSlice[T]) GoString() string {
+	return fmt.Sprintf("slice.RecordSliceFrom[%s](%#v, %#v, nil)", typeName[T](), s.data, s.codec)
+}
+
+// Format implements fmt.Formatter, printing a snapshot of the slice like a Go
+// slice. The zero value is printed as an empty slice
+func (s Synchronized[T]) Format(f fmt.State, verb rune) {
+	goSyntax := s.GoString
+	if s.state == nil {
+		s = Synchronize[T](Wrapper[T]{}).(Synchronized[T])
+	}
+	formatSlice[T](f, verb, s, goSyntax)
+}
+
+// String prints a snapshot of the slice like a Go slice
+func (s Synchronized[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice, while holding a read
+// lock
+func (s Synchronized[T]) GoString() string {
+	if s.state == nil {
+		return fmt.Sprintf("slice.Synchronized[%s]{}", typeName[T]())
+	}
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return fmt.Sprintf("slice.Synchronize(%#v)", s.state.slice)
+}
+
+// Format implements fmt.Formatter, printing the slice like a Go slice. The zero
+// value is printed as an empty slice
+func (s COW[T]) Format(f fmt.State, verb rune) {
+	goSyntax := s.GoString
+	if s.state == nil {
+		s = CopyOnWrite[T](Wrapper[T]{}).(COW[T])
+	}
+	formatSlice[T](f, verb, s, goSyntax)
+}
+
+// String prints the slice like a Go slice
+func (s COW[T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString prints the Go syntax for creating the slice
+func (s COW[T]) GoString() string {
+	if s.state == nil {
+		return fmt.Sprintf("slice.COW[%s]{}", typeName[T]())
+	}
+	return fmt.Sprintf("slice.CopyOnWrite(%#v)", s.state.slice)
+}
@@ -0,0 +1,114 @@
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceFormatTest(t *testing.T, s Slice[int], goSyntax string) {
+	s1 := s.Append(1, 2, 3)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	assert.Equal(t, "[1 2 3]", fmt.Sprintf("%v", s1))
+	assert.Equal(t, "[1 2 3]", fmt.Sprint(s1))
+	assert.Equal(t, "[1 2 3]", s1.(fmt.Stringer).String())
+	assert.Equal(t, "[01 02 03]", fmt.Sprintf("%02d", s1))
+	assert.Equal(t, "[+1 +2 +3]", fmt.Sprintf("%+d", s1))
+	assert.Equal(t, "[0x1 0x2 0x3]", fmt.Sprintf("%#x", s1))
+	assert.Equal(t, "[]", fmt.Sprintf("%v", s1.Slice(0, 0)))
+
+	assert.Equal(t, fmt.Sprintf("%T(len=3 cap=%d)[1 2 3]", s1, s1.Cap()), fmt.Sprintf("%+v", s1))
+	assert.Equal(t, goSyntax, fmt.Sprintf("%#v", s1))
+	assert.Equal(t, goSyntax, s1.(fmt.GoStringer).GoString())
+}
+
+func TestWrapper_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptySlice[int](0, 0), "slice.Wrap([]int{1, 2, 3})")
+	assert.Equal(t, "slice.Wrap([]int{})", fmt.Sprintf("%#v", Wrapper[int]{}))
+}
+
+func TestDistributed_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyDistributed[int](0, 2),
+		"slice.EmptyDistributed[int](0, 2).Append([]int{1, 2, 3}...)")
+
+	// The buckets shouldn't be printed
+	s := EmptyDistributed[string](0, 2).Append("a", "b", "c")
+	assert.Equal(t, "[a b c]", fmt.Sprintf("%v", s))
+	assert.Equal(t, `["a" "b" "c"]`, fmt.Sprintf("%q", s))
+	assert.Equal(t, "slice.Distributed[string](len=3 cap=4)[a b c]", fmt.Sprintf("%+v", s))
+}
+
+func TestSingly_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptySingly[int](), "slice.SinglyFrom([]int{1, 2, 3})")
+}
+
+func TestDoubly_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyDoubly[int](), "slice.DoublyFrom([]int{1, 2, 3})")
+
+	// The elements should be formatted with the same verb
+	s := DoublyFrom([]testReading{{1, 0.5}})
+	assert.Equal(t, "[{1 0.5}]", fmt.Sprintf("%v", s))
+	assert.Contains(t, fmt.Sprintf("%+v", s), "[{Sensor:1 Value:0.5}]")
+}
+
+func TestPersistent_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyPersistent[int](), "slice.PersistentFrom([]int{1, 2, 3})")
+}
+
+func TestRope_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyRope[int](), "slice.RopeFrom([]int{1, 2, 3})")
+}
+
+func TestRing_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyRing[int](128, RingOverwrite),
+		"slice.RingFrom([]int{1, 2, 3}, 128, slice.RingOverwrite)")
+	assert.Equal(t, "slice.RingFrom([]int{1, 2}, 3, slice.RingReject)",
+		fmt.Sprintf("%#v", RingFrom([]int{1, 2}, 3, RingReject)))
+}
+
+func TestGapBuffer_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptyGapBuffer[int](0), "slice.GapBufferFrom([]int{1, 2, 3})")
+}
+
+func TestSkipList_Format(t *testing.T) {
+	commonSliceFormatTest(t, EmptySkipList[int](), "slice.SkipListFrom([]int{1, 2, 3})")
+}
+
+func TestRecordSlice_Format(t *testing.T) {
+	s := RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil)
+	assert.Equal(t, "[1 2]", fmt.Sprintf("%v", s))
+	assert.Equal(t, "slice.RecordSlice[int](len=2 cap=2)[1 2]", fmt.Sprintf("%+v", s))
+	assert.Equal(t, fmt.Sprintf("slice.RecordSliceFrom[int](%#v, slice.intCodec{}, nil)", intRecords(1, 2)),
+		fmt.Sprintf("%#v", s))
+}
+
+func TestSynchronized_Format(t *testing.T) {
+	s := Synchronize(DoublyFrom([]int{1, 2, 3}))
+	assert.Equal(t, "[1 2 3]", fmt.Sprintf("%v", s))
+	assert.Equal(t, "slice.Synchronized[int](len=3 cap=3)[1 2 3]", fmt.Sprintf("%+v", s))
+	assert.Equal(t, "slice.Synchronize(slice.DoublyFrom([]int{1, 2, 3}))", fmt.Sprintf("%#v", s))
+
+	// The zero value should be printed as an empty slice
+	var empty Synchronized[int]
+	assert.Equal(t, "[]", fmt.Sprint(empty))
+	assert.Equal(t, "[]", empty.String())
+	assert.Equal(t, "slice.Synchronized[int](len=0 cap=0)[]", fmt.Sprintf("%+v", empty))
+	assert.Equal(t, "slice.Synchronized[int]{}", fmt.Sprintf("%#v", empty))
+}
+
+func TestCOW_Format(t *testing.T) {
+	s := CopyOnWrite(EmptyDistributed[int](0, 2).Append(1, 2, 3))
+	assert.Equal(t, "[1 2 3]", fmt.Sprintf("%v", s))
+	assert.Equal(t, "slice.COW[int](len=3 cap=4)[1 2 3]", fmt.Sprintf("%+v", s))
+	assert.Equal(t, "slice.CopyOnWrite(slice.EmptyDistributed[int](0, 2).Append([]int{1, 2, 3}...))",
+		fmt.Sprintf("%#v", s))
+
+	// The zero value should be printed as an empty slice
+	var empty COW[int]
+	assert.Equal(t, "[]", fmt.Sprint(empty))
+	assert.Equal(t, "[]", empty.String())
+	assert.Equal(t, "slice.COW[int](len=0 cap=0)[]", fmt.Sprintf("%+v", empty))
+	assert.Equal(t, "slice.COW[int]{}", fmt.Sprintf("%#v", empty))
+}
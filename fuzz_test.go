@@ -0,0 +1,31 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Gets the seed corpus, of random sequences of operations and sequences that
+// have found bugs before
+func modelCorpus() [][]byte {
+	var corpus [][]byte
+	for seed := int64(0); seed < 8; seed++ {
+		corpus = append(corpus, encodeModelOps(randomModelOps(rand.New(rand.NewSource(seed)), 32)))
+	}
+	// Prepending nothing onto an empty Distributed, then appending
+	return append(corpus, []byte("\x01\x00\x00\x00\x00\x00\x00\x00\a\xc6\x00\x00"))
+}
+
+// Runs the operations decoded from the fuzz input against one of the Slice
+// types and a Go slice. The type is picked by impl, so the fuzzer can explore
+// every type with the same operations
+func FuzzModel(f *testing.F) {
+	for i := range sliceFactories {
+		for _, data := range modelCorpus() {
+			f.Add(byte(i), data)
+		}
+	}
+	f.Fuzz(func(t *testing.T, impl byte, data []byte) {
+		checkModelOps(t, sliceFactories[int(impl)%len(sliceFactories)], decodeModelOps(data), "with fuzz input")
+	})
+}
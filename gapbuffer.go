@@ -0,0 +1,326 @@
+package slice
+
+import "fmt"
+
+// The minimum size of the gap when a GapBuffer grows
+const gapBufferMinGap = 16
+
+// GapBuffer is a Slice type, implemented as an array with a "gap" of unused
+// space at a cursor. Inserting and deleting elements at the cursor is
+// amortized O(1), and moving the cursor only moves the elements between the
+// old and new cursor, so a GapBuffer suits many edits close together. Get and
+// Set are O(1). The functions that edit the slice move the cursor to the edit.
+// Warning: like appending to a Go slice, editing the slice can change the
+// elements of other slices that share its array, so the original slice
+// shouldn't be used after it's edited
+type GapBuffer[T any] struct {
+	buf []T
+	// The index of the first element of the gap (which is the cursor), and the
+	// index of the first element after the gap
+	gapStart int
+	gapEnd   int
+}
+
+// EmptyGapBuffer creates an empty GapBuffer Slice, with room for cap elements
+func EmptyGapBuffer[T any](cap int) Slice[T] {
+	return GapBuffer[T]{buf: make([]T, cap), gapEnd: cap}
+}
+
+// GapBufferFrom creates a GapBuffer Slice from a Go slice, with the cursor at
+// the end. The elements are copied
+func GapBufferFrom[T any](elems []T) Slice[T] {
+	return EmptyGapBuffer[T](len(elems) + gapBufferMinGap).Append(elems...)
+}
+
+func (s GapBuffer[T]) gapLen() int {
+	return s.gapEnd - s.gapStart
+}
+
+// Gets the index in buf of the element at the given index
+func (s GapBuffer[T]) physical(i int) int {
+	if i < s.gapStart {
+		return i
+	}
+	return i + s.gapLen()
+}
+
+// Makes sure the gap has room for at least n elements
+func (s GapBuffer[T]) grow(n int) GapBuffer[T] {
+	if s.gapLen() >= n {
+		return s
+	}
+
+	// Double the size of the array, or more if it's still too small
+	size := atLeast(2*len(s.buf), len(s.buf)-s.gapLen()+n+gapBufferMinGap)
+	buf := make([]T, size)
+	copy(buf, s.buf[:s.gapStart])
+	after := len(s.buf) - s.gapEnd
+	copy(buf[size-after:], s.buf[s.gapEnd:])
+	s.buf = buf
+	s.gapEnd = size - after
+	return s
+}
+
+// Cursor gets the index the cursor is at, which is where elements are
+// inserted by InsertAtCursor
+func (s GapBuffer[T]) Cursor() int {
+	return s.gapStart
+}
+
+// MoveCursor moves the cursor to the given index, which can be the length of
+// the slice. Only the elements between the old and new cursor are moved
+func (s GapBuffer[T]) MoveCursor(i int) Slice[T] {
+	if i < 0 || i > s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+
+	if i < s.gapStart {
+		// Move the elements between i and the cursor to the end of the gap
+		n := s.gapStart - i
+		copy(s.buf[s.gapEnd-n:s.gapEnd], s.buf[i:s.gapStart])
+		s.gapStart -= n
+		s.gapEnd -= n
+	} else if i > s.gapStart {
+		// Move the elements between the cursor and i to the start of the gap
+		n := i - s.gapStart
+		copy(s.buf[s.gapStart:s.gapStart+n], s.buf[s.gapEnd:s.gapEnd+n])
+		s.gapStart += n
+		s.gapEnd += n
+	}
+	return s
+}
+
+// InsertAtCursor inserts the given elements at the cursor, then moves the
+// cursor after them, in amortized O(1) time for each element
+func (s GapBuffer[T]) InsertAtCursor(elems ...T) Slice[T] {
+	s = s.grow(len(elems))
+	s.gapStart += copy(s.buf[s.gapStart:], elems)
+	return s
+}
+
+// DeleteBefore deletes the n elements before the cursor, in O(1) time
+func (s GapBuffer[T]) DeleteBefore(n int) Slice[T] {
+	checkRange(s.gapStart-n, s.gapStart, s.Len())
+	s.gapStart -= n
+	return s
+}
+
+// DeleteAfter deletes the n elements after the cursor, in O(1) time
+func (s GapBuffer[T]) DeleteAfter(n int) Slice[T] {
+	checkRange(s.gapStart, s.gapStart+n, s.Len())
+	s.gapEnd += n
+	return s
+}
+
+func (s GapBuffer[T]) Append(elems ...T) Slice[T] {
+	return s.MoveCursor(s.Len()).(GapBuffer[T]).InsertAtCursor(elems...)
+}
+
+func (s GapBuffer[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	return s.Append(elems.ToGoSlice()...)
+}
+
+func (s GapBuffer[T]) Prepend(elems ...T) Slice[T] {
+	return s.MoveCursor(0).(GapBuffer[T]).InsertAtCursor(elems...)
+}
+
+func (s GapBuffer[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return s.Prepend(elems.ToGoSlice()...)
+}
+
+// Replaces the elements between i and j (exclusive) with the given elements,
+// leaving the cursor after them
+func (s GapBuffer[T]) splice(i, j int, elems []T) Slice[T] {
+	checkRange(i, j, s.Len())
+	s = s.MoveCursor(j).(GapBuffer[T])
+	s.gapStart = i
+	return s.InsertAtCursor(elems...)
+}
+
+func (s GapBuffer[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, nil)
+}
+
+func (s GapBuffer[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, nil)
+}
+
+func (s GapBuffer[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s GapBuffer[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems.ToGoSlice())
+}
+
+func (s GapBuffer[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, elems)
+}
+
+// Slice creates a new slice that shares the array with the original slice. If
+// the new slice contains the cursor, the cursor stays at the same element,
+// otherwise it's at the closest end of the new slice
+func (s GapBuffer[T]) Slice(i, j int) Slice[T] {
+	checkRange(i, j, s.Len())
+
+	// If the slice is before the gap
+	if j <= s.gapStart {
+		return GapBuffer[T]{buf: s.buf[i:j:j], gapStart: j - i, gapEnd: j - i}
+	}
+	// If the slice is after the gap
+	if i >= s.gapStart {
+		lo, hi := s.physical(i), s.physical(j)
+		return GapBuffer[T]{buf: s.buf[lo:hi:hi]}
+	}
+	// Otherwise the slice contains the gap
+	hi := s.physical(j)
+	return GapBuffer[T]{
+		buf:      s.buf[i:hi:hi],
+		gapStart: s.gapStart - i,
+		gapEnd:   s.gapEnd - i,
+	}
+}
+
+func (s GapBuffer[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	return s.buf[s.physical(i)]
+}
+
+func (s GapBuffer[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	s.buf[s.physical(i)] = elem
+}
+
+type gapBufferIterator[T any] struct {
+	slice GapBuffer[T]
+	index int
+}
+
+func (i *gapBufferIterator[T]) HasNext() bool {
+	return i.index+1 < i.slice.Len()
+}
+
+func (i *gapBufferIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.index++
+		return true
+	}
+	return false
+}
+
+func (i *gapBufferIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *gapBufferIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *gapBufferIterator[T]) Get() T {
+	return i.slice.Get(i.index)
+}
+
+func (i *gapBufferIterator[T]) Set(elem T) {
+	i.slice.Set(i.index, elem)
+}
+
+func (i *gapBufferIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *gapBufferIterator[T]) Seek(index int) {
+	if index < -1 || index > i.slice.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+}
+
+func (i *gapBufferIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *gapBufferIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to, moving the cursor to the iterator. Inserting or removing
+// elements next to each other is amortized O(1)
+func (i *gapBufferIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.slice = i.slice.Insert(i.index, elem).(GapBuffer[T])
+	i.index++
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to, moving the cursor to the iterator. Inserting or removing
+// elements next to each other is amortized O(1)
+func (i *gapBufferIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.slice.Len() {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.slice = i.slice.Insert(i.index+1, elem).(GapBuffer[T])
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element, moving the cursor to the iterator
+func (i *gapBufferIterator[T]) Remove() {
+	i.slice = i.slice.Erase(i.index).(GapBuffer[T])
+	i.index--
+}
+
+// Slice gets the slice the iterator is iterating over, including the changes
+// made by the iterator
+func (i *gapBufferIterator[T]) Slice() Slice[T] {
+	return i.slice
+}
+
+func (s GapBuffer[T]) IterStart() Iterator[T] {
+	return &gapBufferIterator[T]{slice: s, index: -1}
+}
+
+func (s GapBuffer[T]) IterEnd() Iterator[T] {
+	return &gapBufferIterator[T]{slice: s, index: s.Len()}
+}
+
+func (s GapBuffer[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s GapBuffer[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+// DeepCopy creates a copy of the slice, with the cursor in the same place
+func (s GapBuffer[T]) DeepCopy() Slice[T] {
+	buf := make([]T, len(s.buf))
+	copy(buf, s.buf)
+	s.buf = buf
+	return s
+}
+
+func (s GapBuffer[T]) Len() int {
+	return len(s.buf) - s.gapLen()
+}
+
+// Cap returns the number of elements the slice can hold before it needs to
+// grow
+func (s GapBuffer[T]) Cap() int {
+	return len(s.buf)
+}
+
+func (s GapBuffer[T]) ToGoSlice() []T {
+	slice := make([]T, 0, atLeast(1, s.Len()))
+	slice = append(slice, s.buf[:s.gapStart]...)
+	return append(slice, s.buf[s.gapEnd:]...)
+}
@@ -0,0 +1,168 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGapBuffer_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptyGapBuffer[int](0))
+	commonSliceAppendTest(t, GapBufferFrom([]int{1}))
+	commonSliceAppendTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptyGapBuffer[int](0))
+	commonSlicePrependTest(t, GapBufferFrom([]int{1}))
+	commonSlicePrependTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptyGapBuffer[int](0))
+	commonSliceInsertTest(t, GapBufferFrom([]int{1}))
+	commonSliceInsertTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptyGapBuffer[int](0))
+	commonSliceSliceTest(t, GapBufferFrom([]int{1}))
+	commonSliceSliceTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyGapBuffer[int](0))
+	commonSliceEraseTest(t, GapBufferFrom([]int{1}))
+	commonSliceEraseTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyGapBuffer[int](0))
+	commonSliceReplaceTest(t, GapBufferFrom([]int{1}))
+	commonSliceReplaceTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptyGapBuffer[int](0))
+	commonSliceIterTest(t, GapBufferFrom([]int{1}))
+	commonSliceIterTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptyGapBuffer[int](0))
+	commonSliceReverseIterTest(t, GapBufferFrom([]int{1}))
+	commonSliceReverseIterTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyGapBuffer[int](0))
+	commonSliceRandomAccessIterTest(t, GapBufferFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyGapBuffer[int](0))
+	commonSliceMutableIterTest(t, GapBufferFrom([]int{1}))
+	commonSliceMutableIterTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestGapBuffer_Cursor(t *testing.T) {
+	s := GapBufferFrom([]int{1, 2, 3, 4, 5}).(GapBuffer[int])
+	assert.Equal(t, 5, s.Cursor())
+
+	s = s.MoveCursor(2).(GapBuffer[int])
+	assert.Equal(t, 2, s.Cursor())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToGoSlice())
+
+	// Type some elements, then delete either side of the cursor
+	s = s.InsertAtCursor(6, 7).(GapBuffer[int])
+	assert.Equal(t, 4, s.Cursor())
+	assert.Equal(t, []int{1, 2, 6, 7, 3, 4, 5}, s.ToGoSlice())
+	s = s.DeleteBefore(1).(GapBuffer[int])
+	s = s.DeleteAfter(2).(GapBuffer[int])
+	assert.Equal(t, 3, s.Cursor())
+	assert.Equal(t, []int{1, 2, 6, 5}, s.ToGoSlice())
+	commonSliceGetTest(t, s, 3, 5)
+
+	assert.Panics(t, func() {
+		s.DeleteAfter(2)
+	})
+	assert.Panics(t, func() {
+		s.DeleteBefore(4)
+	})
+	assert.Panics(t, func() {
+		s.MoveCursor(5)
+	})
+
+	// Editing the slice should move the cursor after the edit
+	s = s.Erase(0).(GapBuffer[int])
+	assert.Equal(t, 0, s.Cursor())
+	s = s.Insert(2, 8, 9).(GapBuffer[int])
+	assert.Equal(t, 4, s.Cursor())
+	assert.Equal(t, []int{2, 6, 8, 9, 5}, s.ToGoSlice())
+
+	// Slicing around the cursor should keep it at the same element
+	sliced := s.Slice(1, 5).(GapBuffer[int])
+	assert.Equal(t, 3, sliced.Cursor())
+	assert.Equal(t, []int{6, 8, 9, 5}, sliced.ToGoSlice())
+	sliced = s.Slice(0, 2).(GapBuffer[int])
+	assert.Equal(t, 2, sliced.Cursor())
+	assert.Equal(t, []int{2, 6}, sliced.ToGoSlice())
+	sliced = s.Slice(4, 5).(GapBuffer[int])
+	assert.Equal(t, 0, sliced.Cursor())
+	assert.Equal(t, []int{5}, sliced.ToGoSlice())
+}
+
+func TestGapBuffer_Edits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expected := make([]int, 0)
+	s := EmptyGapBuffer[int](0)
+	for k := 0; k < 2000; k++ {
+		i := r.Intn(len(expected) + 1)
+		j := i + r.Intn(atMost(10, len(expected)-i)+1)
+		elems := make([]int, r.Intn(10))
+		for e := range elems {
+			elems[e] = k
+		}
+		s = s.Replace(i, j, elems...)
+		expected = append(expected[:i], append(elems, expected[j:]...)...)
+
+		// Occasionally take a slice, which might contain the gap
+		if r.Intn(10) == 0 {
+			i = r.Intn(len(expected) + 1)
+			j = i + r.Intn(len(expected)-i+1)
+			s = s.Slice(i, j)
+			expected = expected[i:j]
+		}
+		assert.Equal(t, expected, s.ToGoSlice())
+	}
+}
+
+// BENCHMARKING
+
+func BenchmarkGapBuffer_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptyGapBuffer[int](0))
+}
+
+func BenchmarkGapBuffer_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptyGapBuffer[int](0))
+}
+
+func BenchmarkGapBuffer_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptyGapBuffer[int](0))
+}
+
+func BenchmarkGapBuffer_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptyGapBuffer[int](0))
+}
+
+func BenchmarkGapBuffer_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptyGapBuffer[int](0))
+}
@@ -0,0 +1,390 @@
+package slice
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+// The gob encoding of a slice
+type sliceGob[T any] struct {
+	Elems []T
+	// The bucket capacity of a Distributed slice, or the capacity of a Ring
+	Cap  int
+	Mode RingMode
+}
+
+// Encodes the slice with gob
+func marshalGob[T any](enc sliceGob[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(enc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decodes a slice encoded by marshalGob
+func unmarshalGob[T any](data []byte) (sliceGob[T], error) {
+	var dec sliceGob[T]
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dec)
+	return dec, err
+}
+
+// RegisterGob registers every Slice type with the given element type with
+// gob, so that a Slice[T] interface value can be gob encoded and decoded.
+// RecordSlice isn't registered, as its Codec can't be encoded. It's safe to
+// call RegisterGob more than once
+func RegisterGob[T any]() {
+	gob.Register(Wrapper[T]{})
+	gob.Register(Distributed[T]{})
+	gob.Register(Singly[T]{})
+	gob.Register(Doubly[T]{})
+	gob.Register(Persistent[T]{})
+	gob.Register(Rope[T]{})
+	gob.Register(Ring[T]{})
+	gob.Register(GapBuffer[T]{})
+	gob.Register(SkipList[T]{})
+	gob.Register(Synchronized[T]{})
+	gob.Register(COW[T]{})
+}
+
+// MarshalBinary encodes the slice with gob
+func (s Wrapper[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s})
+}
+
+// UnmarshalBinary decodes a slice encoded by MarshalBinary
+func (s *Wrapper[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = dec.Elems
+	}
+	return err
+}
+
+// GobEncode encodes the slice in the same way as MarshalBinary
+func (s Wrapper[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the slice in the same way as UnmarshalBinary
+func (s *Wrapper[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the slice with gob, including its bucket capacity
+func (s Distributed[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice(), Cap: s.bucketCap})
+}
+
+// UnmarshalBinary decodes a slice encoded by MarshalBinary, with the same
+// bucket capacity
+func (s *Distributed[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = EmptyDistributed[T](0, dec.Cap).Append(dec.Elems...).(Distributed[T])
+	}
+	return err
+}
+
+// GobEncode encodes the slice in the same way as MarshalBinary
+func (s Distributed[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the slice in the same way as UnmarshalBinary
+func (s *Distributed[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the list with gob
+func (s Singly[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a list encoded by MarshalBinary
+func (s *Singly[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = SinglyFrom(dec.Elems).(Singly[T])
+	}
+	return err
+}
+
+// GobEncode encodes the list in the same way as MarshalBinary
+func (s Singly[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the list in the same way as UnmarshalBinary
+func (s *Singly[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the list with gob
+func (s Doubly[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a list encoded by MarshalBinary
+func (s *Doubly[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = DoublyFrom(dec.Elems).(Doubly[T])
+	}
+	return err
+}
+
+// GobEncode encodes the list in the same way as MarshalBinary
+func (s Doubly[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the list in the same way as UnmarshalBinary
+func (s *Doubly[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the slice with gob
+func (s Persistent[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a slice encoded by MarshalBinary
+func (s *Persistent[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = PersistentFrom(dec.Elems).(Persistent[T])
+	}
+	return err
+}
+
+// GobEncode encodes the slice in the same way as MarshalBinary
+func (s Persistent[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the slice in the same way as UnmarshalBinary
+func (s *Persistent[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the rope with gob
+func (s Rope[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a rope encoded by MarshalBinary
+func (s *Rope[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = RopeFrom(dec.Elems).(Rope[T])
+	}
+	return err
+}
+
+// GobEncode encodes the rope in the same way as MarshalBinary
+func (s Rope[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the rope in the same way as UnmarshalBinary
+func (s *Rope[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the ring with gob, including its capacity and mode
+func (s Ring[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice(), Cap: len(s.buf), Mode: s.mode})
+}
+
+// UnmarshalBinary decodes a ring encoded by MarshalBinary, with the same
+// capacity and mode
+func (s *Ring[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = RingFrom(dec.Elems, dec.Cap, dec.Mode).(Ring[T])
+	}
+	return err
+}
+
+// GobEncode encodes the ring in the same way as MarshalBinary
+func (s Ring[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the ring in the same way as UnmarshalBinary
+func (s *Ring[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the buffer with gob
+func (s GapBuffer[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a buffer encoded by MarshalBinary, with the cursor
+// at the end
+func (s *GapBuffer[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = GapBufferFrom(dec.Elems).(GapBuffer[T])
+	}
+	return err
+}
+
+// GobEncode encodes the buffer in the same way as MarshalBinary
+func (s GapBuffer[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the buffer in the same way as UnmarshalBinary
+func (s *GapBuffer[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the list with gob
+func (s SkipList[T]) MarshalBinary() ([]byte, error) {
+	return marshalGob(sliceGob[T]{Elems: s.ToGoSlice()})
+}
+
+// UnmarshalBinary decodes a list encoded by MarshalBinary
+func (s *SkipList[T]) UnmarshalBinary(data []byte) error {
+	dec, err := unmarshalGob[T](data)
+	if err == nil {
+		*s = SkipListFrom(dec.Elems).(SkipList[T])
+	}
+	return err
+}
+
+// GobEncode encodes the list in the same way as MarshalBinary
+func (s SkipList[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the list in the same way as UnmarshalBinary
+func (s *SkipList[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary copies the bytes of the records, which are already encoded
+func (s RecordSlice[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, len(s.data))
+	copy(data, s.data)
+	return data, nil
+}
+
+// UnmarshalBinary copies the given records into memory. The slice must
+// already have a Codec, such as one created by EmptyRecordSlice
+func (s *RecordSlice[T]) UnmarshalBinary(data []byte) error {
+	if s.codec == nil {
+		return errors.New("slice: can't unmarshal into a RecordSlice without a Codec")
+	}
+	if len(data)%s.codec.Size() != 0 {
+		return fmt.Errorf("slice: %d bytes isn't a whole number of %d byte records",
+			len(data), s.codec.Size())
+	}
+	records := make([]byte, len(data))
+	copy(records, data)
+	*s = RecordSlice[T]{data: records, codec: s.codec, grow: growBytes}
+	return nil
+}
+
+// GobEncode encodes the records in the same way as MarshalBinary
+func (s RecordSlice[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the records in the same way as UnmarshalBinary
+func (s *RecordSlice[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// The gob encoding of a Synchronized or COW, which encodes the wrapped slice
+// as an interface value, so its type must be registered with RegisterGob
+type wrapperGob[T any] struct {
+	Slice Slice[T]
+}
+
+// Encodes the slice wrapped by a Synchronized or COW
+func marshalWrapperGob[T any](s Slice[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wrapperGob[T]{Slice: s}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalBinary encodes the wrapped slice with gob, while holding a read lock.
+// If s is the zero value, it's encoded as an empty Wrapper
+func (s Synchronized[T]) MarshalBinary() ([]byte, error) {
+	if s.state == nil {
+		return marshalWrapperGob[T](Wrapper[T]{})
+	}
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return marshalWrapperGob(s.state.slice)
+}
+
+// UnmarshalBinary decodes a slice encoded by MarshalBinary, then replaces the
+// wrapped slice while holding a write lock
+func (s *Synchronized[T]) UnmarshalBinary(data []byte) error {
+	var dec wrapperGob[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dec); err != nil {
+		return err
+	}
+	if s.state == nil {
+		*s = Synchronize(dec.Slice).(Synchronized[T])
+		return nil
+	}
+	s.Update(func(Slice[T]) Slice[T] {
+		return dec.Slice
+	})
+	return nil
+}
+
+// GobEncode encodes the slice in the same way as MarshalBinary
+func (s Synchronized[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the slice in the same way as UnmarshalBinary
+func (s *Synchronized[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the wrapped slice with gob. If s is the zero value,
+// it's encoded as an empty Wrapper
+func (s COW[T]) MarshalBinary() ([]byte, error) {
+	if s.state == nil {
+		return marshalWrapperGob[T](Wrapper[T]{})
+	}
+	return marshalWrapperGob(s.state.slice)
+}
+
+// UnmarshalBinary decodes a slice encoded by MarshalBinary, then replaces the
+// wrapped slice
+func (s *COW[T]) UnmarshalBinary(data []byte) error {
+	var dec wrapperGob[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dec); err != nil {
+		return err
+	}
+	if s.state == nil {
+		*s = CopyOnWrite(dec.Slice).(COW[T])
+		return nil
+	}
+	// The new elements aren't shared with any snapshots
+	*s.state = cowState[T]{slice: dec.Slice}
+	return nil
+}
+
+// GobEncode encodes the slice in the same way as MarshalBinary
+func (s COW[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes the slice in the same way as UnmarshalBinary
+func (s *COW[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
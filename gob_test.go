@@ -0,0 +1,170 @@
+package slice
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	RegisterGob[int]()
+}
+
+// A struct with an interface-typed Slice field
+type gobMessage struct {
+	Name  string
+	Elems Slice[int]
+}
+
+// Encodes then decodes a message with gob
+func gobRoundTrip(t *testing.T, msg gobMessage) gobMessage {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(msg))
+	var decoded gobMessage
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	return decoded
+}
+
+func commonSliceGobTest[S Slice[int]](t *testing.T, s Slice[int], into *S) {
+	s1 := s.Append(1, 2, 3)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	data, err := s1.(encoding.BinaryMarshaler).MarshalBinary()
+	assert.NoError(t, err)
+	assert.NoError(t, any(into).(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+	var decoded Slice[int] = *into
+	assert.Equal(t, []int{1, 2, 3}, decoded.ToGoSlice())
+
+	// The slice should keep its type through an interface field
+	msg := gobRoundTrip(t, gobMessage{Name: "elems", Elems: s1})
+	assert.Equal(t, "elems", msg.Name)
+	assert.IsType(t, s1, msg.Elems)
+	assert.Equal(t, []int{1, 2, 3}, msg.Elems.ToGoSlice())
+
+	msg = gobRoundTrip(t, gobMessage{Elems: s1.Slice(0, 0)})
+	assert.Equal(t, 0, msg.Elems.Len())
+
+	assert.Error(t, any(into).(encoding.BinaryUnmarshaler).UnmarshalBinary([]byte("not gob")))
+}
+
+func TestWrapper_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptySlice[int](0, 0), new(Wrapper[int]))
+	commonSliceGobTest(t, Wrap([]int{1}), new(Wrapper[int]))
+}
+
+func TestDistributed_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyDistributed[int](0, 2), new(Distributed[int]))
+	commonSliceGobTest(t, DistributedFrom([]int{1}), new(Distributed[int]))
+
+	// The bucket capacity should be kept
+	msg := gobRoundTrip(t, gobMessage{Elems: EmptyDistributed[int](0, 3).Append(1, 2, 3, 4)})
+	assert.Equal(t, 3, msg.Elems.(Distributed[int]).bucketCap)
+	assert.Equal(t, []int{1, 2, 3, 4}, msg.Elems.ToGoSlice())
+}
+
+func TestSingly_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptySingly[int](), new(Singly[int]))
+	commonSliceGobTest(t, SinglyFrom([]int{1}), new(Singly[int]))
+}
+
+func TestDoubly_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyDoubly[int](), new(Doubly[int]))
+	commonSliceGobTest(t, DoublyFrom([]int{1}), new(Doubly[int]))
+}
+
+func TestPersistent_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyPersistent[int](), new(Persistent[int]))
+}
+
+func TestRope_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyRope[int](), new(Rope[int]))
+}
+
+func TestRing_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyRing[int](128, RingOverwrite), new(Ring[int]))
+
+	// The capacity and mode should be kept
+	msg := gobRoundTrip(t, gobMessage{Elems: RingFrom([]int{1, 2}, 3, RingReject)})
+	assert.Equal(t, 3, msg.Elems.Cap())
+	assert.Equal(t, RingReject, msg.Elems.(Ring[int]).Mode())
+	assert.Equal(t, []int{1, 2}, msg.Elems.ToGoSlice())
+}
+
+func TestGapBuffer_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptyGapBuffer[int](0), new(GapBuffer[int]))
+}
+
+func TestSkipList_Gob(t *testing.T) {
+	commonSliceGobTest(t, EmptySkipList[int](), new(SkipList[int]))
+}
+
+func TestRecordSlice_Gob(t *testing.T) {
+	// The binary encoding should be the records themselves
+	s := RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil).(RecordSlice[int])
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, intRecords(1, 2), data)
+
+	decoded := EmptyRecordSlice[int](0, 0, intCodec{}).(RecordSlice[int])
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, []int{1, 2}, decoded.ToGoSlice())
+	assert.Error(t, decoded.UnmarshalBinary(data[1:]))
+
+	// The records can't be decoded without a codec
+	var empty RecordSlice[int]
+	assert.Error(t, empty.UnmarshalBinary(data))
+}
+
+func TestSynchronized_Gob(t *testing.T) {
+	s := Synchronize(EmptyDistributed[int](0, 3).Append(1, 2, 3, 4))
+	msg := gobRoundTrip(t, gobMessage{Elems: s})
+	assert.IsType(t, s, msg.Elems)
+	assert.Equal(t, 3, msg.Elems.(Synchronized[int]).load().(Distributed[int]).bucketCap)
+	assert.Equal(t, []int{1, 2, 3, 4}, msg.Elems.ToGoSlice())
+
+	// Every copy of the slice should see the decoded elements
+	data, err := s.(Synchronized[int]).MarshalBinary()
+	assert.NoError(t, err)
+	into := Synchronize(Wrap([]int{5})).(Synchronized[int])
+	cpy := into
+	assert.NoError(t, into.UnmarshalBinary(data))
+	assert.Equal(t, []int{1, 2, 3, 4}, cpy.ToGoSlice())
+
+	// The zero value should be encoded as an empty slice
+	msg = gobRoundTrip(t, gobMessage{Elems: Synchronized[int]{}})
+	commonSliceLenTest(t, msg.Elems, 0)
+	data, err = Synchronized[int]{}.MarshalBinary()
+	assert.NoError(t, err)
+	var empty Synchronized[int]
+	assert.NoError(t, empty.UnmarshalBinary(data))
+	commonSliceLenTest(t, empty, 0)
+}
+
+func TestCOW_Gob(t *testing.T) {
+	s := CopyOnWrite(DoublyFrom([]int{1, 2, 3}))
+	msg := gobRoundTrip(t, gobMessage{Elems: s})
+	assert.IsType(t, s, msg.Elems)
+	assert.IsType(t, Doubly[int]{}, msg.Elems.(COW[int]).state.slice)
+	assert.Equal(t, []int{1, 2, 3}, msg.Elems.ToGoSlice())
+
+	// Decoding shouldn't change the snapshot
+	data, err := s.(COW[int]).MarshalBinary()
+	assert.NoError(t, err)
+	into := CopyOnWrite(Wrap([]int{5})).(COW[int])
+	snap := into.Snapshot()
+	assert.NoError(t, into.UnmarshalBinary(data))
+	assert.Equal(t, []int{1, 2, 3}, into.ToGoSlice())
+	assert.Equal(t, []int{5}, snap.ToGoSlice())
+
+	// The zero value should be encoded as an empty slice
+	msg = gobRoundTrip(t, gobMessage{Elems: COW[int]{}})
+	commonSliceLenTest(t, msg.Elems, 0)
+	data, err = COW[int]{}.MarshalBinary()
+	assert.NoError(t, err)
+	var empty COW[int]
+	assert.NoError(t, empty.UnmarshalBinary(data))
+	commonSliceLenTest(t, empty, 0)
+}
@@ -0,0 +1,323 @@
+package slice
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Encodes the elements of the slice as a JSON array
+func marshalJSON[T any](s Slice[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	iter := s.IterStart()
+	for i := 0; iter.Next(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		data, err := json.Marshal(iter.Get())
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// Decodes a JSON array into a slice of the same type as like
+func unmarshalJSON[T any](like Slice[T], data []byte) (Slice[T], error) {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return nil, err
+	}
+	return from(like, elems), nil
+}
+
+// MarshalJSON encodes the slice as a JSON array
+func (s Wrapper[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the slice
+func (s *Wrapper[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*[]T)(s))
+}
+
+// MarshalJSON encodes the slice as a JSON array
+func (s Distributed[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the slice. If the slice already has
+// a bucket capacity, it's kept
+func (s *Distributed[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Distributed[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (s Singly[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the list
+func (s *Singly[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Singly[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (s Doubly[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the list
+func (s *Doubly[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Doubly[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the slice as a JSON array
+func (s Persistent[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the slice
+func (s *Persistent[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Persistent[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the rope as a JSON array
+func (s Rope[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the rope
+func (s *Rope[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Rope[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the ring as a JSON array, from its first element
+func (s Ring[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the ring. The mode of the ring is
+// kept, and the capacity is grown if the elements don't fit
+func (s *Ring[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(Ring[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the buffer as a JSON array
+func (s GapBuffer[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the buffer
+func (s *GapBuffer[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(GapBuffer[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (s SkipList[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into the list
+func (s *SkipList[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(SkipList[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the decoded records as a JSON array
+func (s RecordSlice[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// UnmarshalJSON decodes a JSON array into new records in memory. The slice
+// must already have a Codec, such as one created by EmptyRecordSlice
+func (s *RecordSlice[T]) UnmarshalJSON(data []byte) error {
+	if s.codec == nil {
+		return errors.New("slice: can't unmarshal into a RecordSlice without a Codec")
+	}
+	decoded, err := unmarshalJSON[T](*s, data)
+	if err == nil {
+		*s = decoded.(RecordSlice[T])
+	}
+	return err
+}
+
+// MarshalJSON encodes the slice as a JSON array, while holding a read lock. If
+// s is the zero value, it's encoded as an empty array
+func (s Synchronized[T]) MarshalJSON() ([]byte, error) {
+	if s.state == nil {
+		return []byte("[]"), nil
+	}
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return marshalJSON(s.state.slice)
+}
+
+// UnmarshalJSON decodes a JSON array, then replaces the elements of the
+// wrapped slice while holding a write lock. If s is the zero value, the
+// elements are wrapped in a Wrapper
+func (s *Synchronized[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	if s.state == nil {
+		*s = Synchronize(Wrap(elems)).(Synchronized[T])
+		return nil
+	}
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return from(slice, elems)
+	})
+	return nil
+}
+
+// MarshalJSON encodes the slice as a JSON array. If s is the zero value, it's
+// encoded as an empty array
+func (s COW[T]) MarshalJSON() ([]byte, error) {
+	if s.state == nil {
+		return []byte("[]"), nil
+	}
+	return marshalJSON(s.state.slice)
+}
+
+// UnmarshalJSON decodes a JSON array, then replaces the elements of the
+// wrapped slice. If s is the zero value, the elements are wrapped in a Wrapper
+func (s *COW[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	if s.state == nil {
+		*s = CopyOnWrite(Wrap(elems)).(COW[T])
+		return nil
+	}
+	// The new elements aren't shared with any snapshots
+	*s.state = cowState[T]{slice: from(s.state.slice, elems)}
+	return nil
+}
+
+// JSONField holds a Slice so it can be decoded from JSON, such as when it's a
+// struct field. json.Unmarshal can't decode into a field typed Slice[T], as it
+// doesn't know which type to create, and it only decodes into the value held by
+// an interface if that value is a pointer. JSONField decodes into a slice of
+// the same type as the one it holds, or a Wrapper if it's empty
+type JSONField[T any] struct {
+	Slice[T]
+}
+
+// MarshalJSON encodes the held slice as a JSON array, or null if there isn't one
+func (f JSONField[T]) MarshalJSON() ([]byte, error) {
+	if f.Slice == nil {
+		return []byte("null"), nil
+	}
+	return marshalJSON(f.Slice)
+}
+
+// UnmarshalJSON decodes a JSON array into a slice of the same type as the held
+// slice, or a Wrapper if there isn't one
+func (f *JSONField[T]) UnmarshalJSON(data []byte) error {
+	like := f.Slice
+	if like == nil {
+		like = Wrapper[T](nil)
+	}
+	decoded, err := unmarshalJSON(like, data)
+	if err == nil {
+		f.Slice = decoded
+	}
+	return err
+}
+
+// DecodeJSONArray decodes a JSON array from dec one element at a time,
+// appending each element onto s as it's decoded, and returns the new slice.
+// Unlike json.Unmarshal, the whole array is never held in memory as a Go
+// slice, so decoding into a Distributed slice only allocates its buckets. If
+// the next value is null, s is returned unchanged
+func DecodeJSONArray[T any](dec *json.Decoder, s Slice[T]) (Slice[T], error) {
+	token, err := dec.Token()
+	if err != nil {
+		return s, err
+	}
+	if token == nil {
+		return s, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return s, fmt.Errorf("slice: expected a JSON array, got %v", token)
+	}
+
+	for dec.More() {
+		var elem T
+		if err := dec.Decode(&elem); err != nil {
+			return s, err
+		}
+		s = s.Append(elem)
+	}
+	// Read the closing ]
+	if _, err := dec.Token(); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+// WriteNDJSON writes the elements of s to w as newline-delimited JSON, with
+// one element on each line
+func WriteNDJSON[T any](w io.Writer, s Slice[T]) error {
+	enc := json.NewEncoder(w)
+	iter := s.IterStart()
+	for iter.Next() {
+		if err := enc.Encode(iter.Get()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadNDJSON reads newline-delimited JSON from r until the end of the input,
+// appending each value onto s, and returns the new slice
+func ReadNDJSON[T any](r io.Reader, s Slice[T]) (Slice[T], error) {
+	dec := json.NewDecoder(r)
+	for {
+		var elem T
+		if err := dec.Decode(&elem); err == io.EOF {
+			return s, nil
+		} else if err != nil {
+			return s, err
+		}
+		s = s.Append(elem)
+	}
+}
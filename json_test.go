@@ -0,0 +1,231 @@
+package slice
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceJSONTest[S Slice[int]](t *testing.T, s Slice[int], into *S) {
+	s1 := s.Append(1, 2, 3)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	data, err := json.Marshal(s1)
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,2,3]", string(data))
+
+	// A Slice field should be encoded as an array too
+	data, err = json.Marshal(struct{ S Slice[int] }{s1})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"S":[1,2,3]}`, string(data))
+
+	data, err = json.Marshal(s1.Slice(0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("[4, 5, 6, 7]"), into))
+	var decoded Slice[int] = *into
+	assert.Equal(t, []int{4, 5, 6, 7}, decoded.ToGoSlice())
+
+	// A field of the concrete type can be decoded directly
+	field := struct{ S S }{*into}
+	assert.NoError(t, json.Unmarshal([]byte(`{"S":[8,9]}`), &field))
+	decoded = field.S
+	assert.Equal(t, []int{8, 9}, decoded.ToGoSlice())
+
+	// A Slice field can't be, as json.Unmarshal doesn't know its type
+	sliceField := struct{ S Slice[int] }{*into}
+	assert.Error(t, json.Unmarshal([]byte(`{"S":[8,9]}`), &sliceField))
+
+	// A JSONField should be decoded into the type it holds
+	jsonField := struct{ S JSONField[int] }{JSONField[int]{*into}}
+	assert.NoError(t, json.Unmarshal([]byte(`{"S":[10,11]}`), &jsonField))
+	assert.IsType(t, *into, jsonField.S.Slice)
+	assert.Equal(t, []int{10, 11}, jsonField.S.ToGoSlice())
+	data, err = json.Marshal(jsonField)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"S":[10,11]}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`["a"]`), into))
+	assert.Error(t, json.Unmarshal([]byte(`{}`), into))
+}
+
+func TestWrapper_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptySlice[int](0, 0), new(Wrapper[int]))
+	commonSliceJSONTest(t, Wrap([]int{1}), new(Wrapper[int]))
+}
+
+func TestDistributed_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyDistributed[int](0, 2), new(Distributed[int]))
+	commonSliceJSONTest(t, DistributedFrom([]int{1}), new(Distributed[int]))
+
+	// The bucket capacity should be kept
+	s := EmptyDistributed[int](0, 3).(Distributed[int])
+	assert.NoError(t, json.Unmarshal([]byte("[1, 2, 3, 4]"), &s))
+	assert.Equal(t, 3, s.bucketCap)
+	assert.Equal(t, []int{1, 2, 3, 4}, s.ToGoSlice())
+}
+
+func TestSingly_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptySingly[int](), new(Singly[int]))
+	commonSliceJSONTest(t, SinglyFrom([]int{1}), new(Singly[int]))
+}
+
+func TestDoubly_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyDoubly[int](), new(Doubly[int]))
+	commonSliceJSONTest(t, DoublyFrom([]int{1}), new(Doubly[int]))
+}
+
+func TestPersistent_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyPersistent[int](), new(Persistent[int]))
+}
+
+func TestRope_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyRope[int](), new(Rope[int]))
+}
+
+func TestRing_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyRing[int](128, RingOverwrite), new(Ring[int]))
+
+	// The mode should be kept, and the capacity grown to fit the elements
+	s := EmptyRing[int](2, RingReject).(Ring[int])
+	assert.NoError(t, json.Unmarshal([]byte("[1, 2, 3]"), &s))
+	assert.Equal(t, RingReject, s.Mode())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+}
+
+func TestGapBuffer_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptyGapBuffer[int](0), new(GapBuffer[int]))
+}
+
+func TestSkipList_JSON(t *testing.T) {
+	commonSliceJSONTest(t, EmptySkipList[int](), new(SkipList[int]))
+}
+
+func TestRecordSlice_JSON(t *testing.T) {
+	s := EmptyRecordSlice[int](0, 0, intCodec{}).(RecordSlice[int])
+	commonSliceJSONTest(t, s, &s)
+
+	// The records can't be decoded without a codec
+	var empty RecordSlice[int]
+	assert.Error(t, json.Unmarshal([]byte("[1]"), &empty))
+}
+
+func TestSynchronized_JSON(t *testing.T) {
+	s := Synchronize(DistributedFrom([]int{1, 2, 3})).(Synchronized[int])
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,2,3]", string(data))
+
+	// Every copy of the slice should see the decoded elements
+	cpy := s
+	assert.NoError(t, json.Unmarshal([]byte("[4, 5]"), &s))
+	assert.Equal(t, []int{4, 5}, cpy.ToGoSlice())
+	assert.IsType(t, Distributed[int]{}, s.load())
+
+	// The zero value should be encoded as an empty array
+	var empty Synchronized[int]
+	data, err = json.Marshal(empty)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+	assert.NoError(t, json.Unmarshal([]byte("[4, 5]"), &empty))
+	assert.Equal(t, []int{4, 5}, empty.ToGoSlice())
+}
+
+func TestCOW_JSON(t *testing.T) {
+	s := CopyOnWrite(DistributedFrom([]int{1, 2, 3})).(COW[int])
+	snap := s.Snapshot()
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,2,3]", string(data))
+
+	// Decoding shouldn't change the snapshot
+	assert.NoError(t, json.Unmarshal([]byte("[4, 5]"), &s))
+	assert.Equal(t, []int{4, 5}, s.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, snap.ToGoSlice())
+
+	// The zero value should be encoded as an empty array
+	var empty COW[int]
+	data, err = json.Marshal(empty)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+	assert.NoError(t, json.Unmarshal([]byte("[4, 5]"), &empty))
+	assert.Equal(t, []int{4, 5}, empty.ToGoSlice())
+}
+
+func TestJSONField(t *testing.T) {
+	// A Slice field can be decoded if it holds a pointer to the concrete type
+	var d Distributed[int]
+	field := struct{ S Slice[int] }{&d}
+	assert.NoError(t, json.Unmarshal([]byte(`{"S":[1,2]}`), &field))
+	assert.Equal(t, []int{1, 2}, d.ToGoSlice())
+
+	// An empty JSONField should be decoded into a Wrapper
+	var empty struct{ S JSONField[int] }
+	data, err := json.Marshal(empty)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"S":null}`, string(data))
+	assert.NoError(t, json.Unmarshal([]byte(`{"S":[1,2]}`), &empty))
+	assert.IsType(t, Wrapper[int]{}, empty.S.Slice)
+	assert.Equal(t, []int{1, 2}, empty.S.ToGoSlice())
+
+	// The bucket capacity of a held Distributed should be kept
+	s := struct{ S JSONField[int] }{JSONField[int]{EmptyDistributed[int](0, 3)}}
+	assert.NoError(t, json.Unmarshal([]byte(`{"S":[1,2,3,4]}`), &s))
+	assert.Equal(t, 3, s.S.Slice.(Distributed[int]).bucketCap)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"S":{}}`), &s))
+	assert.Equal(t, []int{1, 2, 3, 4}, s.S.ToGoSlice())
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`[1, 2, 3] null [4] {} [5, "a"]`))
+	s, err := DecodeJSONArray(dec, EmptyDistributed[int](0, 2))
+	assert.NoError(t, err)
+	assert.IsType(t, Distributed[int]{}, s)
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+
+	// null should leave the slice unchanged
+	s, err = DecodeJSONArray(dec, s)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+
+	s, err = DecodeJSONArray(dec, s)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, s.ToGoSlice())
+
+	_, err = DecodeJSONArray(dec, s)
+	assert.Error(t, err)
+
+	// The elements decoded before the error should be kept
+	dec = json.NewDecoder(strings.NewReader(`[5, "a"]`))
+	s, err = DecodeJSONArray(dec, s)
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToGoSlice())
+}
+
+func TestNDJSON(t *testing.T) {
+	type event struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	events := []event{{1, "a"}, {2, "b"}, {3, "c"}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteNDJSON(&buf, DistributedFrom(events)))
+	assert.Equal(t, `{"id":1,"name":"a"}
+{"id":2,"name":"b"}
+{"id":3,"name":"c"}
+`, buf.String())
+
+	s, err := ReadNDJSON(&buf, EmptyDistributed[event](0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, events, s.ToGoSlice())
+
+	s, err = ReadNDJSON(strings.NewReader("{\"id\":4}\nnot json\n"), s)
+	assert.Error(t, err)
+	assert.Equal(t, append(events, event{ID: 4}), s.ToGoSlice())
+}
@@ -0,0 +1,446 @@
+package slice
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The kinds of operation the model-based tests run
+type modelOpKind uint8
+
+const (
+	modelAppend modelOpKind = iota
+	modelPrepend
+	modelSlice
+	modelSet
+	modelInsert
+	modelErase
+	modelReplace
+	modelIter
+	modelOpKinds
+)
+
+// An operation run against both a Slice and a Go slice. The indexes a and b are
+// wrapped into the range of the slice when the operation is run, so that any
+// sequence of operations is valid
+type modelOp struct {
+	kind  modelOpKind
+	a, b  int
+	elems []int
+}
+
+// Gets simpler versions of the operation, for shrinking
+func (op modelOp) simplifications() []modelOp {
+	var ops []modelOp
+	if len(op.elems) > 0 {
+		ops = append(ops,
+			modelOp{op.kind, op.a, op.b, op.elems[1:]},
+			modelOp{op.kind, op.a, op.b, op.elems[:len(op.elems)-1]})
+	}
+	if op.a != 0 {
+		ops = append(ops, modelOp{op.kind, 0, op.b, op.elems})
+	}
+	if op.b != 0 {
+		ops = append(ops, modelOp{op.kind, op.a, 0, op.elems})
+	}
+	for i, elem := range op.elems {
+		if elem != 0 {
+			elems := append([]int{}, op.elems...)
+			elems[i] = 0
+			ops = append(ops, modelOp{op.kind, op.a, op.b, elems})
+		}
+	}
+	return ops
+}
+
+// Creates n random operations
+func randomModelOps(r *rand.Rand, n int) []modelOp {
+	ops := make([]modelOp, n)
+	for i := range ops {
+		ops[i] = modelOp{
+			kind:  modelOpKind(r.Intn(int(modelOpKinds))),
+			a:     r.Intn(256),
+			b:     r.Intn(256),
+			elems: make([]int, r.Intn(5)),
+		}
+		for k := range ops[i].elems {
+			ops[i].elems[k] = r.Intn(100)
+		}
+	}
+	return ops
+}
+
+// Decodes operations from fuzzer input. Each operation is its kind, a, b and
+// the number of elements, followed by the elements
+func decodeModelOps(data []byte) []modelOp {
+	var ops []modelOp
+	for len(data) >= 4 {
+		op := modelOp{
+			kind:  modelOpKind(data[0] % byte(modelOpKinds)),
+			a:     int(data[1]),
+			b:     int(data[2]),
+			elems: make([]int, 0, data[3]%5),
+		}
+		data = data[4:]
+		for len(op.elems) < cap(op.elems) && len(data) > 0 {
+			op.elems = append(op.elems, int(int8(data[0])))
+			data = data[1:]
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// Encodes operations as fuzzer input, the reverse of decodeModelOps
+func encodeModelOps(ops []modelOp) []byte {
+	var data []byte
+	for _, op := range ops {
+		data = append(data, byte(op.kind), byte(op.a), byte(op.b), byte(len(op.elems)))
+		for _, elem := range op.elems {
+			data = append(data, byte(int8(elem)))
+		}
+	}
+	return data
+}
+
+// Runs the operations against a slice created by impl and a Go slice, checking
+// that they match after every operation. Returns the calls that were made, and
+// an error describing the first mismatch or panic
+func runModel(impl sliceFactory, ops []modelOp) (trace []string, err error) {
+	s, model := impl.from(nil), []int{}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s panicked: %v", trace[len(trace)-1], r)
+		}
+	}()
+
+	for _, op := range ops {
+		n := len(model)
+		// The slice before the operation, which Erase, Insert and Replace are
+		// made from
+		prev, edited := s, false
+		switch op.kind {
+		case modelAppend:
+			trace = append(trace, fmt.Sprintf("Append(%v)", op.elems))
+			s = s.Append(op.elems...)
+			model = append(model, op.elems...)
+		case modelPrepend:
+			trace = append(trace, fmt.Sprintf("Prepend(%v)", op.elems))
+			s = s.Prepend(op.elems...)
+			model = append(append([]int{}, op.elems...), model...)
+		case modelSlice:
+			i := op.a % (n + 1)
+			j := i + op.b%(n-i+1)
+			trace = append(trace, fmt.Sprintf("Slice(%d, %d)", i, j))
+			s = s.Slice(i, j)
+			model = append([]int{}, model[i:j]...)
+		case modelSet:
+			if n == 0 {
+				continue
+			}
+			i := op.a % n
+			trace = append(trace, fmt.Sprintf("Set(%d, %d)", i, op.b))
+			s.Set(i, op.b)
+			model[i] = op.b
+		case modelInsert:
+			i := op.a % (n + 1)
+			trace = append(trace, fmt.Sprintf("Insert(%d, %v)", i, op.elems))
+			s, edited = s.Insert(i, op.elems...), true
+			model = append(model[:i:i], append(append([]int{}, op.elems...), model[i:]...)...)
+		case modelErase:
+			if n == 0 {
+				continue
+			}
+			i := op.a % n
+			j := i + op.b%(n-i)
+			if i == j {
+				trace = append(trace, fmt.Sprintf("Erase(%d)", i))
+				s, edited = s.Erase(i), true
+			} else {
+				trace = append(trace, fmt.Sprintf("EraseRange(%d, %d)", i, j))
+				s, edited = s.EraseRange(i, j), true
+			}
+			model = append(model[:i:i], model[j+1:]...)
+		case modelReplace:
+			i := op.a % (n + 1)
+			j := i + op.b%(n-i+1)
+			trace = append(trace, fmt.Sprintf("Replace(%d, %d, %v)", i, j, op.elems))
+			s, edited = s.Replace(i, j, op.elems...), true
+			model = append(model[:i:i], append(append([]int{}, op.elems...), model[j:]...)...)
+		case modelIter:
+			trace = append(trace, fmt.Sprintf("Iter(%d, %d)", op.a, op.b))
+			if err := checkModelIter(impl, s, model, op.a, op.b); err != nil {
+				return trace, fmt.Errorf("%s: %v", trace[len(trace)-1], err)
+			}
+			continue
+		}
+
+		if err := checkModel(s, model); err != nil {
+			return trace, fmt.Errorf("%s: %v", trace[len(trace)-1], err)
+		}
+		// Unless the slice is a handle to the same elements, the slice the
+		// edit was made from should still be readable
+		if edited && !impl.handle {
+			if err := checkModelEditedFrom(prev, n); err != nil {
+				return trace, fmt.Errorf("%s: %v", trace[len(trace)-1], err)
+			}
+		}
+	}
+	return trace, nil
+}
+
+// Checks a slice that an edit was made from. Like a Go slice, its elements can
+// be stale, but reading it shouldn't panic, and it should keep its length
+func checkModelEditedFrom(s Slice[int], n int) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("reading the slice it was made from panicked: %v", r)
+		}
+	}()
+	if s.Len() != n {
+		return fmt.Errorf("the slice it was made from has Len() = %d, expected %d", s.Len(), n)
+	}
+	for i := 0; i < n; i++ {
+		s.Get(i)
+	}
+	if got := s.ToGoSlice(); len(got) != n {
+		return fmt.Errorf("the slice it was made from has ToGoSlice() = %v, expected %d elements", got, n)
+	}
+	for iter := s.IterEnd(); iter.Prev(); {
+		iter.Get()
+	}
+	return nil
+}
+
+// Checks the slice's length and elements against the model
+func checkModel(s Slice[int], model []int) error {
+	if s.Len() != len(model) {
+		return fmt.Errorf("Len() = %d, expected %d", s.Len(), len(model))
+	}
+	for i, elem := range model {
+		if got := s.Get(i); got != elem {
+			return fmt.Errorf("Get(%d) = %d, expected %d", i, got, elem)
+		}
+	}
+	if got := s.ToGoSlice(); !assert.ObjectsAreEqual(model, got) && len(model)+len(got) > 0 {
+		return fmt.Errorf("ToGoSlice() = %v, expected %v", got, model)
+	}
+	return nil
+}
+
+// Moves an iterator over the slice in one of the ways picked by mode, checking
+// the elements it's pointed to against the model
+func checkModelIter(impl sliceFactory, s Slice[int], model []int, mode, steps int) error {
+	n := len(model)
+	switch mode % 4 {
+	case 0:
+		// Walk forwards to the end
+		iter := s.IterStart()
+		for i := 0; i < n; i++ {
+			if !iter.Next() {
+				return fmt.Errorf("Next() = false at index %d", i)
+			} else if iter.Get() != model[i] {
+				return fmt.Errorf("Get() = %d at index %d, expected %d", iter.Get(), i, model[i])
+			}
+		}
+		if iter.Next() {
+			return fmt.Errorf("Next() = true at the end")
+		}
+	case 1:
+		// Walk backwards from the end
+		if !impl.prev {
+			return nil
+		}
+		iter := s.IterEnd()
+		for i := n - 1; i >= n-steps%(n+1); i-- {
+			if !iter.Prev() {
+				return fmt.Errorf("Prev() = false at index %d", i)
+			} else if iter.Get() != model[i] {
+				return fmt.Errorf("Get() = %d at index %d, expected %d", iter.Get(), i, model[i])
+			}
+		}
+	case 2:
+		// Walk a reverse iterator
+		if !impl.prev {
+			return nil
+		}
+		iter := s.ReverseIterStart()
+		for i := n - 1; i >= 0; i-- {
+			if !iter.Next() {
+				return fmt.Errorf("reverse Next() = false at index %d", i)
+			} else if iter.Get() != model[i] {
+				return fmt.Errorf("reverse Get() = %d at index %d, expected %d", iter.Get(), i, model[i])
+			}
+		}
+		if iter.Next() {
+			return fmt.Errorf("reverse Next() = true at the start")
+		}
+	case 3:
+		// Seek to an element, then move back and forth from it
+		iter, ok := s.IterStart().(RandomAccessIterator[int])
+		if !ok || n == 0 {
+			return nil
+		}
+		i := steps % n
+		iter.Seek(i)
+		if iter.Index() != i || iter.Get() != model[i] {
+			return fmt.Errorf("after Seek(%d), Index() = %d and Get() = %d, expected %d",
+				i, iter.Index(), iter.Get(), model[i])
+		}
+		if i > 0 {
+			iter.Advance(-1)
+			if iter.Get() != model[i-1] {
+				return fmt.Errorf("after Advance(-1) from %d, Get() = %d, expected %d", i, iter.Get(), model[i-1])
+			}
+			iter.Advance(1)
+		}
+		if iter.Next() != (i < n-1) {
+			return fmt.Errorf("Next() from %d = %t", i, i >= n-1)
+		}
+	}
+	return nil
+}
+
+// Shrinks a sequence of operations that fails, first by removing operations,
+// then by simplifying the ones left, until nothing can be removed or
+// simplified without the sequence passing
+func shrinkModelOps(ops []modelOp, fails func([]modelOp) bool) []modelOp {
+	for shrunk := true; shrunk; {
+		shrunk = false
+
+		// Remove chunks of operations, from the whole sequence down to single
+		// operations
+		for n := len(ops); n >= 1; n /= 2 {
+			for i := 0; i+n <= len(ops); {
+				candidate := append(append([]modelOp{}, ops[:i]...), ops[i+n:]...)
+				if fails(candidate) {
+					ops, shrunk = candidate, true
+				} else {
+					i += n
+				}
+			}
+		}
+
+		for i := range ops {
+			for _, op := range ops[i].simplifications() {
+				candidate := append([]modelOp{}, ops...)
+				candidate[i] = op
+				if fails(candidate) {
+					ops, shrunk = candidate, true
+					break
+				}
+			}
+		}
+	}
+	return ops
+}
+
+// Runs the operations against the implementation, and if they fail, shrinks
+// them and fails the test with the calls that were made
+func checkModelOps(t *testing.T, impl sliceFactory, ops []modelOp, msg string) {
+	if _, err := runModel(impl, ops); err == nil {
+		return
+	}
+	ops = shrinkModelOps(ops, func(ops []modelOp) bool {
+		_, err := runModel(impl, ops)
+		return err != nil
+	})
+	trace, err := runModel(impl, ops)
+	t.Fatalf("%s %s: %v\ncalls:\n\t%s\nfuzz input: %q",
+		impl.name, msg, err, strings.Join(trace, "\n\t"), encodeModelOps(ops))
+}
+
+func TestModel(t *testing.T) {
+	sequences := 300
+	if testing.Short() {
+		sequences = 30
+	}
+	for _, impl := range sliceFactories {
+		impl := impl
+		t.Run(impl.name, func(t *testing.T) {
+			for seed := int64(0); seed < int64(sequences); seed++ {
+				ops := randomModelOps(rand.New(rand.NewSource(seed)), 64)
+				checkModelOps(t, impl, ops, fmt.Sprintf("with seed %d", seed))
+			}
+		})
+	}
+}
+
+func TestModel_Encoding(t *testing.T) {
+	ops := randomModelOps(rand.New(rand.NewSource(1)), 32)
+	assert.Equal(t, ops, decodeModelOps(encodeModelOps(ops)))
+	assert.Empty(t, decodeModelOps([]byte{1, 2, 3}))
+}
+
+func TestModel_Shrink(t *testing.T) {
+	// A "bug" that needs an Append followed by a Prepend of a 7 to happen
+	fails := func(ops []modelOp) bool {
+		appended := false
+		for _, op := range ops {
+			if op.kind == modelAppend && len(op.elems) > 0 {
+				appended = true
+			} else if appended && op.kind == modelPrepend {
+				for _, elem := range op.elems {
+					if elem == 7 {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	ops := randomModelOps(rand.New(rand.NewSource(1)), 64)
+	ops = append(ops, modelOp{kind: modelAppend, a: 3, elems: []int{1, 2}})
+	ops = append(ops, randomModelOps(rand.New(rand.NewSource(2)), 64)...)
+	ops = append(ops, modelOp{kind: modelPrepend, b: 5, elems: []int{4, 7, 9}})
+	assert.Equal(t, []modelOp{
+		{kind: modelAppend, elems: []int{0}},
+		{kind: modelPrepend, elems: []int{7}},
+	}, shrinkModelOps(ops, fails))
+
+	// A failure caused by a buggy implementation should be shrunk to the calls
+	// that cause it
+	buggy := sliceFactory{name: "buggy", from: func([]int) Slice[int] {
+		return buggySlice{Wrapper[int]{}}
+	}}
+	ops = []modelOp{
+		{kind: modelAppend, elems: []int{1, 2, 3, 4}},
+		{kind: modelSet, a: 3, b: 5},
+		{kind: modelIter, a: 1},
+		{kind: modelAppend, elems: []int{6, 7}},
+		{kind: modelErase, a: 2},
+		{kind: modelIter},
+	}
+	ops = shrinkModelOps(ops, func(ops []modelOp) bool {
+		_, err := runModel(buggy, ops)
+		return err != nil
+	})
+	trace, err := runModel(buggy, ops)
+	assert.EqualError(t, err, "Erase(2): Get(2) = 0, expected 4")
+	assert.Equal(t, []string{"Append([0 0 0 4])", "Erase(2)"}, trace)
+}
+
+// A Wrapper that erases the wrong element when erasing from the middle
+type buggySlice struct {
+	Wrapper[int]
+}
+
+func (s buggySlice) wrap(slice Slice[int]) Slice[int] {
+	return buggySlice{slice.(Wrapper[int])}
+}
+
+func (s buggySlice) Append(elems ...int) Slice[int] {
+	return s.wrap(s.Wrapper.Append(elems...))
+}
+
+func (s buggySlice) Erase(i int) Slice[int] {
+	if i > 0 && i < s.Len()-1 {
+		i++
+	}
+	return s.wrap(s.Wrapper.Erase(i))
+}
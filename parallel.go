@@ -0,0 +1,204 @@
+package slice
+
+import (
+	"runtime"
+	"sync"
+)
+
+// The number of chunks each worker gets, when a slice doesn't have natural
+// boundaries. More chunks balance the work better when some elements take
+// longer than others
+const parallelChunksPerWorker = 4
+
+// A contiguous part of a slice, which can be iterated over on its own. It
+// calls f with the index and value of each element in the chunk, in order
+type parallelChunk[T any] func(f func(int, T))
+
+// Splits the slice into chunks on its natural boundaries. Distributed slices
+// are split into buckets, Wrappers into contiguous ranges, and linked lists
+// into ranges of nodes, which are found by walking the list once
+func parallelChunks[T any](s Slice[T], workers int) []parallelChunk[T] {
+	n := s.Len()
+	if n == 0 {
+		return nil
+	}
+	size := atLeast(1, (n+workers*parallelChunksPerWorker-1)/(workers*parallelChunksPerWorker))
+
+	var chunks []parallelChunk[T]
+	switch s := s.(type) {
+	case Distributed[T]:
+		start := 0
+		for b := range s.buckets {
+			elems, first := s.bucket(b), start
+			if len(elems) == 0 {
+				continue
+			}
+			chunks = append(chunks, func(f func(int, T)) {
+				for k, elem := range elems {
+					f(first+k, elem)
+				}
+			})
+			start += len(elems)
+		}
+
+	case Wrapper[T]:
+		for start := 0; start < n; start += size {
+			elems, first := s[start:atMost(start+size, n)], start
+			chunks = append(chunks, func(f func(int, T)) {
+				for k, elem := range elems {
+					f(first+k, elem)
+				}
+			})
+		}
+
+	case Singly[T]:
+		node := s.start
+		for start := 0; start < n; start += size {
+			first, count, from := start, atMost(size, n-start), node
+			chunks = append(chunks, func(f func(int, T)) {
+				node := from
+				for k := 0; k < count; k++ {
+					f(first+k, node.Get())
+					if node != nil {
+						node = node.next
+					}
+				}
+			})
+			for k := 0; k < count; k++ {
+				if node != nil {
+					node = node.next
+				}
+			}
+		}
+
+	case Doubly[T]:
+		node := s.start
+		for start := 0; start < n; start += size {
+			first, count, from := start, atMost(size, n-start), node
+			chunks = append(chunks, func(f func(int, T)) {
+				node := from
+				for k := 0; k < count; k++ {
+					f(first+k, node.Get())
+					if node != nil {
+						node = node.next
+					}
+				}
+			})
+			for k := 0; k < count; k++ {
+				if node != nil {
+					node = node.next
+				}
+			}
+		}
+
+	// Otherwise split the slice into ranges with Slice
+	default:
+		for start := 0; start < n; start += size {
+			part, first := s.Slice(start, atMost(start+size, n)), start
+			chunks = append(chunks, func(f func(int, T)) {
+				iter := part.IterStart()
+				for k := first; iter.Next(); k++ {
+					f(k, iter.Get())
+				}
+			})
+		}
+	}
+	return chunks
+}
+
+// Calls work with the index of each chunk, using the given number of
+// goroutines. If work panics, the panic is passed on to the calling goroutine
+// once every goroutine has stopped
+func parallelDo(chunks, workers int, work func(int)) {
+	workers = atMost(workers, chunks)
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var panicked interface{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range next {
+				func() {
+					defer func() {
+						if r := recover(); r != nil {
+							once.Do(func() {
+								panicked = r
+							})
+						}
+					}()
+					work(c)
+				}()
+			}
+		}()
+	}
+	for c := 0; c < chunks; c++ {
+		next <- c
+	}
+	close(next)
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
+// Gets the number of workers to use. If workers isn't positive, it's the
+// number of CPUs Go is using
+func parallelWorkers(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
+
+// ParallelForEach calls f with the index and value of each element of s,
+// using the given number of goroutines. If workers is 0, runtime.GOMAXPROCS
+// goroutines are used. The slice is split into chunks on its natural
+// boundaries, such as the buckets of a Distributed slice, and each chunk is
+// iterated over in order by one goroutine, but the chunks are iterated over in
+// any order. s mustn't be modified until ParallelForEach returns
+func ParallelForEach[T any](s Slice[T], workers int, f func(int, T)) {
+	workers = parallelWorkers(workers)
+	chunks := parallelChunks(s, workers)
+	parallelDo(len(chunks), workers, func(c int) {
+		chunks[c](f)
+	})
+}
+
+// ParallelMap is like Map, but calls f on the elements using the given number
+// of goroutines, in the same way as ParallelForEach. The elements of the new
+// slice are in the same order as s
+func ParallelMap[T, U any](s Slice[T], workers int, f func(T) U) Slice[U] {
+	elems := make([]U, s.Len())
+	ParallelForEach(s, workers, func(i int, elem T) {
+		elems[i] = f(elem)
+	})
+	return from(s, elems)
+}
+
+// ParallelReduce is like Reduce, but splits s into chunks in the same way as
+// ParallelForEach. Each chunk is reduced in order, starting with init, then
+// the results of the chunks are combined in order with combine. This means
+// init must not change the result when it's combined with another result, and
+// combine must be associative, like adding numbers starting from 0
+func ParallelReduce[T, U any](s Slice[T], workers int, init U, f func(U, T) U, combine func(U, U) U) U {
+	workers = parallelWorkers(workers)
+	chunks := parallelChunks(s, workers)
+	results := make([]U, len(chunks))
+	parallelDo(len(chunks), workers, func(c int) {
+		result := init
+		chunks[c](func(_ int, elem T) {
+			result = f(result, elem)
+		})
+		results[c] = result
+	})
+
+	result := init
+	for _, r := range results {
+		result = combine(result, r)
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package slice
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceParallelTest(t *testing.T, s Slice[int]) {
+	elems := make([]int, 1000)
+	for i := range elems {
+		elems[i] = i + 1
+	}
+	s1 := s.Append(elems...)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	for _, workers := range []int{0, 1, 3, 2000} {
+		// Every element should be visited once, with the right index
+		var mu sync.Mutex
+		visited := make([]int, s1.Len())
+		ParallelForEach(s1, workers, func(i int, elem int) {
+			mu.Lock()
+			defer mu.Unlock()
+			visited[i] += elem
+		})
+		assert.Equal(t, elems, visited)
+
+		mapped := ParallelMap(s1, workers, strconv.Itoa)
+		assert.IsType(t, from[int, string](s1, nil), mapped)
+		assert.Equal(t, Map(s1, strconv.Itoa).ToGoSlice(), mapped.ToGoSlice())
+
+		sum := ParallelReduce(s1, workers, 0, func(sum, elem int) int {
+			return sum + elem
+		}, func(a, b int) int {
+			return a + b
+		})
+		assert.Equal(t, 500500, sum)
+
+		// The chunks should be combined in order
+		str := ParallelReduce(s1.Slice(0, 12), workers, "", func(str string, elem int) string {
+			return str + strconv.Itoa(elem)
+		}, func(a, b string) string {
+			return a + b
+		})
+		assert.Equal(t, "123456789101112", str)
+	}
+
+	// The panic should be passed on to the caller
+	assert.PanicsWithValue(t, "oops", func() {
+		ParallelForEach(s1, 4, func(i int, elem int) {
+			if i == 500 {
+				panic("oops")
+			}
+		})
+	})
+
+	empty := s1.Slice(0, 0)
+	ParallelForEach(empty, 4, func(int, int) {
+		t.Error("the slice should be empty")
+	})
+	assert.Equal(t, 0, ParallelMap(empty, 4, strconv.Itoa).Len())
+}
+
+// Checks a linked list that an edit was made from can still be read in
+// parallel, with its unreachable elements as the zero value
+func commonLinkedListEditedFromParallelTest(t *testing.T, s Slice[int]) {
+	s.Erase(1)
+	sum := ParallelReduce(s, 4, 0, func(sum, elem int) int {
+		return sum + elem
+	}, func(a, b int) int {
+		return a + b
+	})
+	assert.Equal(t, 1+3+4, sum)
+}
+
+func TestWrapper_Parallel(t *testing.T) {
+	commonSliceParallelTest(t, EmptySlice[int](0, 0))
+	commonSliceParallelTest(t, Wrap([]int{1}))
+}
+
+func TestDistributed_Parallel(t *testing.T) {
+	commonSliceParallelTest(t, EmptyDistributed[int](0, 7))
+	commonSliceParallelTest(t, DistributedFrom([]int{1}))
+	commonSliceParallelTest(t, EmptyDistributed[int](0, 3).Append(1, 2))
+}
+
+func TestSingly_Parallel(t *testing.T) {
+	commonSliceParallelTest(t, EmptySingly[int]())
+	commonSliceParallelTest(t, SinglyFrom([]int{1}))
+	commonLinkedListEditedFromParallelTest(t, SinglyFrom([]int{1, 2, 3, 4}))
+}
+
+func TestDoubly_Parallel(t *testing.T) {
+	commonSliceParallelTest(t, EmptyDoubly[int]())
+	commonSliceParallelTest(t, DoublyFrom([]int{1}))
+	commonLinkedListEditedFromParallelTest(t, DoublyFrom([]int{1, 2, 3, 4}))
+}
+
+func TestRope_Parallel(t *testing.T) {
+	commonSliceParallelTest(t, EmptyRope[int]())
+	commonSliceParallelTest(t, RopeFrom([]int{1}))
+}
+
+func BenchmarkDistributed_ParallelMap(b *testing.B) {
+	s := EmptyDistributed[int](0, 0)
+	for i := 0; i < 100000; i++ {
+		s = s.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParallelMap(s, 0, func(elem int) int {
+			return elem * 2
+		})
+	}
+}
@@ -0,0 +1,482 @@
+package slice
+
+import "fmt"
+
+// The number of bits of an index used by each level of a Persistent trie
+const persistentBits = 5
+
+// The number of children (or elements) of each Persistent trie node
+const persistentWidth = 1 << persistentBits
+
+// The mask for the bits of an index used by a level of a Persistent trie
+const persistentMask = persistentWidth - 1
+
+// A node of a Persistent trie. Branch nodes only have children, and leaf nodes
+// only have elements. Nodes are never modified after they're shared
+type persistentNode[T any] struct {
+	children []*persistentNode[T]
+	elems    []T
+}
+
+func newPersistentBranch[T any]() *persistentNode[T] {
+	return &persistentNode[T]{children: make([]*persistentNode[T], persistentWidth)}
+}
+
+// Copies the node, so that the copy can be modified
+func (n *persistentNode[T]) copy() *persistentNode[T] {
+	if n.children != nil {
+		children := make([]*persistentNode[T], persistentWidth)
+		copy(children, n.children)
+		return &persistentNode[T]{children: children}
+	}
+	elems := make([]T, persistentWidth)
+	copy(elems, n.elems)
+	return &persistentNode[T]{elems: elems}
+}
+
+// The trie of a Persistent. Setting an element copies the path down to it, then
+// replaces the root or tail here, so the change is seen by every copy of the
+// Persistent value, but not by the slices created from it
+type persistentTrie[T any] struct {
+	root *persistentNode[T]
+	// The elements after the last leaf in the trie. The tail is never modified
+	// after it's shared
+	tail []T
+	// The number of elements that have been set, so iterators know when the
+	// leaf they found might be out of date
+	sets int
+}
+
+// Persistent is a persistent Slice type, implemented as a 32-way trie with a
+// tail buffer, similar to Clojure's persistent vector. Functions that would
+// modify the slice instead return a new slice, which shares most of its
+// structure with the original, so old versions of the slice never change.
+// Appending, setting and slicing are O(log32 n), but prepending and inserting
+// copy the elements after the insertion point
+//
+// Warning: Set is the exception. Like setting an element of a Go slice, it
+// changes the slice it's called on and every copy of it, including plain
+// copies like `snapshot := s`. Only With, DeepCopy and Slice (and the
+// functions that return a new slice, such as Append) create independent
+// versions, so use With to set an element without changing the original
+type Persistent[T any] struct {
+	trie  *persistentTrie[T]
+	shift uint
+	// The number of elements in the trie and tail, including those before
+	// offset
+	count int
+	// The index of the first element of the slice
+	offset int
+}
+
+// EmptyPersistent creates an empty Persistent Slice
+func EmptyPersistent[T any]() Slice[T] {
+	return Persistent[T]{
+		trie:  &persistentTrie[T]{root: newPersistentBranch[T]()},
+		shift: persistentBits,
+	}
+}
+
+// Gives the slice its own trie, so it can be modified without changing the
+// slice it was created from
+func (s Persistent[T]) fork() Persistent[T] {
+	if s.trie == nil {
+		s.trie = &persistentTrie[T]{}
+	} else {
+		trie := *s.trie
+		s.trie = &trie
+	}
+	return s
+}
+
+// PersistentFrom creates a Persistent Slice from a Go slice
+func PersistentFrom[T any](elems []T) Slice[T] {
+	return EmptyPersistent[T]().Append(elems...)
+}
+
+// Gets the index of the first element in the tail
+func (s Persistent[T]) tailOffset() int {
+	if s.count < persistentWidth {
+		return 0
+	}
+	return ((s.count - 1) >> persistentBits) << persistentBits
+}
+
+// Gets the leaf (or the tail) that contains the element at the given index. The
+// index includes the elements before offset
+func (s Persistent[T]) leaf(i int) []T {
+	if i >= s.tailOffset() {
+		return s.trie.tail
+	}
+	node := s.trie.root
+	for level := s.shift; level > 0; level -= persistentBits {
+		node = node.children[(i>>level)&persistentMask]
+	}
+	return node.elems
+}
+
+// Creates a path of branches down to the given node
+func newPersistentPath[T any](level uint, node *persistentNode[T]) *persistentNode[T] {
+	if level == 0 {
+		return node
+	}
+	path := newPersistentBranch[T]()
+	path.children[0] = newPersistentPath(level-persistentBits, node)
+	return path
+}
+
+// Copies the path down to the last leaf, and adds the given leaf after it
+func (s Persistent[T]) pushLeaf(level uint, parent, leaf *persistentNode[T]) *persistentNode[T] {
+	node := parent.copy()
+	index := ((s.count - 1) >> level) & persistentMask
+	if level == persistentBits {
+		node.children[index] = leaf
+	} else if child := parent.children[index]; child != nil {
+		node.children[index] = s.pushLeaf(level-persistentBits, child, leaf)
+	} else {
+		node.children[index] = newPersistentPath(level-persistentBits, leaf)
+	}
+	return node
+}
+
+// Gives the slice its own trie and a copy of the tail with room for a full
+// leaf, so that elements can be pushed onto it
+func (s Persistent[T]) forkTail() Persistent[T] {
+	s = s.fork()
+	tail := make([]T, len(s.trie.tail), persistentWidth)
+	copy(tail, s.trie.tail)
+	s.trie.tail = tail
+	return s
+}
+
+// Appends an element onto the end of the trie. The trie and its tail are
+// modified, so they must not be shared with another slice (see forkTail)
+func (s Persistent[T]) push(elem T) Persistent[T] {
+	// If the slice is the zero value, create the root
+	if s.trie.root == nil {
+		s.trie.root = newPersistentBranch[T]()
+		s.shift = persistentBits
+	}
+
+	// If there is room in the tail
+	if s.count-s.tailOffset() < persistentWidth {
+		s.trie.tail = append(s.trie.tail, elem)
+		s.count++
+		return s
+	}
+
+	// Otherwise move the tail into the trie
+	leaf := &persistentNode[T]{elems: s.trie.tail}
+	// If the root is full
+	if (s.count >> persistentBits) > (1 << s.shift) {
+		// Add a level to the trie
+		root := newPersistentBranch[T]()
+		root.children[0] = s.trie.root
+		root.children[1] = newPersistentPath(s.shift, leaf)
+		s.trie.root = root
+		s.shift += persistentBits
+	} else {
+		s.trie.root = s.pushLeaf(s.shift, s.trie.root, leaf)
+	}
+
+	tail := make([]T, 1, persistentWidth)
+	tail[0] = elem
+	s.trie.tail = tail
+	s.count++
+	return s
+}
+
+// Copies the path down to the given element, and sets it
+func (s Persistent[T]) assoc(level uint, parent *persistentNode[T], i int, elem T) *persistentNode[T] {
+	node := parent.copy()
+	if level == 0 {
+		node.elems[i&persistentMask] = elem
+	} else {
+		index := (i >> level) & persistentMask
+		node.children[index] = s.assoc(level-persistentBits, parent.children[index], i, elem)
+	}
+	return node
+}
+
+// Copies the path down to the leaf at the given index, removing every node
+// after it
+func trimPersistent[T any](level uint, parent *persistentNode[T], i int) *persistentNode[T] {
+	node := parent.copy()
+	index := (i >> level) & persistentMask
+	for k := index + 1; k < persistentWidth; k++ {
+		node.children[k] = nil
+	}
+	if level > persistentBits {
+		node.children[index] = trimPersistent(level-persistentBits, parent.children[index], i)
+	}
+	return node
+}
+
+// Removes the elements from the given index onwards. The index includes the
+// elements before offset. The trie is modified, so it must not be shared with
+// another slice
+func (s Persistent[T]) truncate(n int) Persistent[T] {
+	if n == s.count {
+		return s
+	}
+	if n == 0 {
+		return EmptyPersistent[T]().(Persistent[T])
+	}
+
+	// If the elements being removed are all in the tail
+	tailOffset := s.tailOffset()
+	if n > tailOffset {
+		s.trie.tail = s.trie.tail[: n-tailOffset : n-tailOffset]
+		s.count = n
+		return s
+	}
+
+	// Otherwise the new tail is the leaf with the last element
+	leaf := s.leaf(n - 1)
+	last := ((n - 1) & persistentMask) + 1
+	s.trie.tail = leaf[:last:last]
+	s.count = n
+
+	// If there are no leaves before the new tail
+	newTailOffset := s.tailOffset()
+	if newTailOffset == 0 {
+		s.trie.root = newPersistentBranch[T]()
+		s.shift = persistentBits
+		return s
+	}
+
+	// Otherwise remove the leaves from the new tail onwards
+	s.trie.root = trimPersistent(s.shift, s.trie.root, newTailOffset-1)
+	// Remove any levels that are no longer needed
+	for s.shift > persistentBits && s.trie.root.children[1] == nil {
+		s.trie.root = s.trie.root.children[0]
+		s.shift -= persistentBits
+	}
+	return s
+}
+
+func (s Persistent[T]) Append(elems ...T) Slice[T] {
+	s = s.forkTail()
+	for _, elem := range elems {
+		s = s.push(elem)
+	}
+	return s
+}
+
+func (s Persistent[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	s = s.forkTail()
+	iter := elems.IterStart()
+	for iter.Next() {
+		s = s.push(iter.Get())
+	}
+	return s
+}
+
+// Prepend creates a new slice with the given elements at the start, in O(n)
+// time
+func (s Persistent[T]) Prepend(elems ...T) Slice[T] {
+	return PersistentFrom(elems).AppendSlice(s)
+}
+
+// PrependSlice creates a new slice with the elements in the given slice at the
+// start, in O(n) time
+func (s Persistent[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return EmptyPersistent[T]().AppendSlice(elems).AppendSlice(s)
+}
+
+// Replaces the elements between i and j (exclusive) with the elements in the
+// given slice. The elements before i are shared with the original slice, but
+// the elements from j onwards are copied
+func (s Persistent[T]) splice(i, j int, elems Slice[T]) Slice[T] {
+	checkRange(i, j, s.Len())
+	return s.Slice(0, i).AppendSlice(elems).AppendSlice(s.Slice(j, s.Len()))
+}
+
+func (s Persistent[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, Wrapper[T]{})
+}
+
+func (s Persistent[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, Wrapper[T]{})
+}
+
+func (s Persistent[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, Wrap(elems))
+}
+
+func (s Persistent[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s Persistent[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, Wrap(elems))
+}
+
+// Slice creates a new slice that shares the elements between i and j
+// (exclusive) with the original slice
+func (s Persistent[T]) Slice(i, j int) Slice[T] {
+	if j < i {
+		panic(fmt.Sprintf("invalid slice index: %d > %d", i, j))
+	}
+
+	// If the slice needs to be grown
+	if j > s.Len() {
+		s = s.Append(make([]T, j-s.Len())...).(Persistent[T])
+	} else {
+		s = s.fork()
+	}
+
+	s = s.truncate(s.offset + j)
+	s.offset += i
+	return s
+}
+
+func (s Persistent[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	i += s.offset
+	return s.leaf(i)[i&persistentMask]
+}
+
+// Set sets the element at the given index, which changes the slice and any
+// copies of it. Only the path down to the element is copied, so the slices
+// that were created from s, or that s was created from, don't change
+func (s Persistent[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	i += s.offset
+
+	// If the element is in the tail
+	if i >= s.tailOffset() {
+		tail := make([]T, len(s.trie.tail), persistentWidth)
+		copy(tail, s.trie.tail)
+		tail[i&persistentMask] = elem
+		s.trie.tail = tail
+	} else {
+		s.trie.root = s.assoc(s.shift, s.trie.root, i, elem)
+	}
+	s.trie.sets++
+}
+
+// With creates a new slice where the element at the given index is set to the
+// given element. Only the path down to the element is copied
+func (s Persistent[T]) With(i int, elem T) Slice[T] {
+	s = s.fork()
+	s.Set(i, elem)
+	return s
+}
+
+type persistentIterator[T any] struct {
+	slice Persistent[T]
+	index int
+	// The leaf that contains the current element
+	leaf []T
+	// The number of elements that had been set in the slice when the leaf was
+	// found
+	sets int
+}
+
+func (i *persistentIterator[T]) HasNext() bool {
+	return i.index+1 < i.slice.Len()
+}
+
+func (i *persistentIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.Seek(i.index + 1)
+		return true
+	}
+	return false
+}
+
+func (i *persistentIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *persistentIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.Seek(i.index - 1)
+		return true
+	}
+	return false
+}
+
+func (i *persistentIterator[T]) Get() T {
+	// If an element has been set since the leaf was found, it may have been
+	// copied
+	if i.leaf != nil && i.sets != i.slice.trie.sets {
+		i.Seek(i.index)
+	}
+	return i.leaf[(i.index+i.slice.offset)&persistentMask]
+}
+
+// Set sets the current element, in the same way as Persistent.Set
+func (i *persistentIterator[T]) Set(elem T) {
+	i.slice.Set(i.index, elem)
+}
+
+func (i *persistentIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *persistentIterator[T]) Seek(index int) {
+	length := i.slice.Len()
+	if index < -1 || index > length {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+
+	// Only find the leaf if the element is in a different leaf
+	realIndex := index + i.slice.offset
+	if index == -1 || index == length {
+		i.leaf = nil
+	} else if i.leaf == nil || i.index == -1 || i.index == length ||
+		realIndex>>persistentBits != (i.index+i.slice.offset)>>persistentBits ||
+		i.sets != i.slice.trie.sets {
+		i.leaf = i.slice.leaf(realIndex)
+		i.sets = i.slice.trie.sets
+	}
+	i.index = index
+}
+
+func (i *persistentIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *persistentIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+func (s Persistent[T]) IterStart() Iterator[T] {
+	return &persistentIterator[T]{slice: s, index: -1}
+}
+
+func (s Persistent[T]) IterEnd() Iterator[T] {
+	return &persistentIterator[T]{slice: s, index: s.Len()}
+}
+
+func (s Persistent[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s Persistent[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+// DeepCopy creates a new slice that shares the elements with the original, as
+// setting an element of either slice only copies the path down to it
+func (s Persistent[T]) DeepCopy() Slice[T] {
+	return s.fork()
+}
+
+func (s Persistent[T]) Len() int {
+	return s.count - s.offset
+}
+
+func (s Persistent[T]) Cap() int {
+	return s.Len()
+}
+
+func (s Persistent[T]) ToGoSlice() []T {
+	return ToGoSlice[T](s)
+}
@@ -0,0 +1,190 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistent_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptyPersistent[int]())
+	commonSliceAppendTest(t, PersistentFrom([]int{1}))
+	commonSliceAppendTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptyPersistent[int]())
+	commonSlicePrependTest(t, PersistentFrom([]int{1}))
+	commonSlicePrependTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptyPersistent[int]())
+	commonSliceInsertTest(t, PersistentFrom([]int{1}))
+	commonSliceInsertTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptyPersistent[int]())
+	commonSliceSliceTest(t, PersistentFrom([]int{1}))
+	commonSliceSliceTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyPersistent[int]())
+	commonSliceEraseTest(t, PersistentFrom([]int{1}))
+	commonSliceEraseTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyPersistent[int]())
+	commonSliceReplaceTest(t, PersistentFrom([]int{1}))
+	commonSliceReplaceTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptyPersistent[int]())
+	commonSliceIterTest(t, PersistentFrom([]int{1}))
+	commonSliceIterTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptyPersistent[int]())
+	commonSliceReverseIterTest(t, PersistentFrom([]int{1}))
+	commonSliceReverseIterTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyPersistent[int]())
+	commonSliceRandomAccessIterTest(t, PersistentFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestPersistent_Versions(t *testing.T) {
+	// Build enough versions to need several levels of the trie
+	const n = 40000
+	versions := make([]Slice[int], 0, n+1)
+	s := EmptyPersistent[int]()
+	versions = append(versions, s)
+	for i := 0; i < n; i++ {
+		s = s.Append(i)
+		versions = append(versions, s)
+	}
+
+	// Every old version should be unchanged
+	for _, i := range []int{0, 1, 31, 32, 33, 1023, 1024, 1056, 32768, 32800, n} {
+		v := versions[i]
+		commonSliceLenTest(t, v, i)
+		for _, k := range []int{0, i / 2, i - 1} {
+			if k >= 0 && k < i {
+				commonSliceGetTest(t, v, k, k)
+			}
+		}
+	}
+
+	// Setting an element should only change the new version
+	for _, i := range []int{0, 31, 32, 1024, 32767, n - 1} {
+		set := s.(Persistent[int]).With(i, -1)
+		commonSliceGetTest(t, set, i, -1)
+		commonSliceGetTest(t, s, i, i)
+	}
+
+	// Setting an element in place should change copies of the slice, but not
+	// the versions it was created from, or that were created from it
+	set := s.DeepCopy()
+	cpy := set
+	setAppended := set.Append(n)
+	for _, i := range []int{0, 31, 32, 1024, 32767, n - 1} {
+		set.Set(i, -1)
+		commonSliceGetTest(t, cpy, i, -1)
+		commonSliceGetTest(t, s, i, i)
+		commonSliceGetTest(t, setAppended, i, i)
+	}
+	assert.Panics(t, func() {
+		set.Set(n, 1)
+	})
+
+	// Setting through an iterator should be seen by other iterators, even if
+	// they've already found the leaf
+	iter := set.IterStart()
+	other := set.IterStart()
+	iter.Next()
+	other.Next()
+	assert.Equal(t, -1, other.Get())
+	iter.Set(-2)
+	assert.Equal(t, -2, other.Get())
+	assert.Equal(t, -2, set.Get(0))
+	assert.True(t, other.Next())
+	assert.Equal(t, 1, other.Get())
+
+	// Slicing then appending should share the start, without changing the
+	// original
+	for _, i := range []int{0, 5, 32, 33, 1024, 1025, 32768, 32769} {
+		sliced := s.Slice(0, i)
+		commonSliceLenTest(t, sliced, i)
+		appended := sliced.Append(-2)
+		commonSliceGetTest(t, appended, i, -2)
+		if i > 0 {
+			commonSliceGetTest(t, appended, i-1, i-1)
+		}
+		commonSliceGetTest(t, s, i, i)
+		commonSliceLenTest(t, s, n)
+	}
+	sliced := s.Slice(1000, 34000)
+	commonSliceLenTest(t, sliced, 33000)
+	commonSliceGetTest(t, sliced, 0, 1000)
+	commonSliceGetTest(t, sliced, 32999, 33999)
+	assert.Equal(t, versions[34000].ToGoSlice()[1000:], sliced.ToGoSlice())
+
+	// Appending to a slice of a slice should still produce the right elements
+	appended := sliced.Slice(10, 20).Append(-3, -4)
+	assert.Equal(t, []int{1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, -3, -4},
+		appended.ToGoSlice())
+}
+
+func TestPersistent_AppendTail(t *testing.T) {
+	// Appending to the same version twice shouldn't write over the first
+	// version's tail
+	s := PersistentFrom([]int{1, 2})
+	first := s.Append(3, 4)
+	second := s.Append(5)
+	assert.Equal(t, []int{1, 2}, s.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3, 4}, first.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 5}, second.ToGoSlice())
+
+	// The tail should be copied once per Append, rather than once per element
+	elems := make([]int, persistentWidth-len(s.ToGoSlice()))
+	allocs := testing.AllocsPerRun(10, func() {
+		s.Append(elems...)
+	})
+	assert.LessOrEqual(t, allocs, 4.0)
+}
+
+// BENCHMARKING
+
+func BenchmarkPersistent_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptyPersistent[int]())
+}
+
+func BenchmarkPersistent_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptyPersistent[int]())
+}
+
+func BenchmarkPersistent_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptyPersistent[int]())
+}
+
+func BenchmarkPersistent_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptyPersistent[int]())
+}
+
+func BenchmarkPersistent_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptyPersistent[int]())
+}
@@ -0,0 +1,361 @@
+package slice
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+)
+
+// Codec is an interface type for encoding and decoding elements as fixed-size
+// records of bytes
+type Codec[T any] interface {
+	// Size gets the number of bytes in a record
+	Size() int
+
+	// Encode encodes the element into the given record, which is Size bytes
+	Encode([]byte, T)
+
+	// Decode decodes an element from the given record, which is Size bytes
+	Decode([]byte) T
+}
+
+type marshalerCodec[T encoding.BinaryMarshaler, P interface {
+	*T
+	encoding.BinaryUnmarshaler
+}] struct {
+	size int
+}
+
+// MarshalerCodec creates a Codec for a type that implements
+// encoding.BinaryMarshaler, and whose pointer type implements
+// encoding.BinaryUnmarshaler. Every element must marshal to exactly size
+// bytes. The codec panics if an element can't be marshaled or unmarshaled
+func MarshalerCodec[T encoding.BinaryMarshaler, P interface {
+	*T
+	encoding.BinaryUnmarshaler
+}](size int) Codec[T] {
+	return marshalerCodec[T, P]{size: size}
+}
+
+func (c marshalerCodec[T, P]) Size() int {
+	return c.size
+}
+
+func (c marshalerCodec[T, P]) Encode(record []byte, elem T) {
+	data, err := elem.MarshalBinary()
+	if err != nil {
+		panic(fmt.Sprintf("slice: can't marshal a record: %v", err))
+	}
+	if len(data) != c.size {
+		panic(fmt.Sprintf("slice: record is %d bytes, expected %d", len(data), c.size))
+	}
+	copy(record, data)
+}
+
+func (c marshalerCodec[T, P]) Decode(record []byte) T {
+	var elem T
+	if err := P(&elem).UnmarshalBinary(record); err != nil {
+		panic(fmt.Sprintf("slice: can't unmarshal a record: %v", err))
+	}
+	return elem
+}
+
+type binaryCodec[T any] struct {
+	order binary.ByteOrder
+	size  int
+}
+
+// BinaryCodec creates a Codec that encodes elements with the encoding/binary
+// package, in the given byte order. T must be a fixed-size type, such as a
+// number or a struct of numbers
+func BinaryCodec[T any](order binary.ByteOrder) Codec[T] {
+	var elem T
+	size := binary.Size(elem)
+	if size < 0 {
+		panic(fmt.Sprintf("slice: %T isn't a fixed-size type", elem))
+	}
+	return binaryCodec[T]{order: order, size: size}
+}
+
+func (c binaryCodec[T]) Size() int {
+	return c.size
+}
+
+func (c binaryCodec[T]) Encode(record []byte, elem T) {
+	// The buffer has enough capacity, so it writes directly into the record
+	buf := bytes.NewBuffer(record[:0])
+	if err := binary.Write(buf, c.order, elem); err != nil {
+		panic(fmt.Sprintf("slice: can't encode a record: %v", err))
+	}
+}
+
+func (c binaryCodec[T]) Decode(record []byte) T {
+	var elem T
+	if err := binary.Read(bytes.NewReader(record), c.order, &elem); err != nil {
+		panic(fmt.Sprintf("slice: can't decode a record: %v", err))
+	}
+	return elem
+}
+
+// GrowFunc is a function that grows the bytes of a RecordSlice. It returns a
+// byte slice with the same length and contents as data, and a capacity of at
+// least cap bytes. For a memory-mapped file, this would extend the file and
+// map it again
+type GrowFunc func(data []byte, cap int) []byte
+
+// Grows the bytes by copying them into a new array, like append
+func growBytes(data []byte, cap int) []byte {
+	grown := make([]byte, len(data), atLeast(cap, 2*len(data)))
+	copy(grown, data)
+	return grown
+}
+
+// RecordSlice is a Slice type, implemented over a []byte of fixed-size
+// records, which are encoded and decoded with a Codec. The bytes can come
+// from anywhere, such as a memory-mapped file, so a RecordSlice can be used
+// for more elements than fit in memory. Get and Set decode and encode the
+// record in place. Like a Go slice, slices created from a RecordSlice share
+// its bytes
+type RecordSlice[T any] struct {
+	data  []byte
+	codec Codec[T]
+	grow  GrowFunc
+}
+
+// EmptyRecordSlice creates a RecordSlice with the given length and capacity,
+// in records. The bytes are allocated in memory
+func EmptyRecordSlice[T any](len, cap int, codec Codec[T]) Slice[T] {
+	return RecordSlice[T]{
+		data:  make([]byte, len*codec.Size(), cap*codec.Size()),
+		codec: codec,
+		grow:  growBytes,
+	}
+}
+
+// RecordSliceFrom creates a RecordSlice from the given bytes, which must be a
+// whole number of records. The capacity of data is used for new records, and
+// grow is called when more capacity is needed. If grow is nil, the bytes are
+// copied into a bigger array
+func RecordSliceFrom[T any](data []byte, codec Codec[T], grow GrowFunc) Slice[T] {
+	if len(data)%codec.Size() != 0 {
+		panic(fmt.Sprintf("slice: %d bytes isn't a whole number of %d byte records",
+			len(data), codec.Size()))
+	}
+	if grow == nil {
+		grow = growBytes
+	}
+	return RecordSlice[T]{data: data, codec: codec, grow: grow}
+}
+
+// Bytes gets the bytes of the records in the slice
+func (s RecordSlice[T]) Bytes() []byte {
+	return s.data
+}
+
+// Gets the record at the given index
+func (s RecordSlice[T]) record(i int) []byte {
+	size := s.codec.Size()
+	return s.data[i*size : (i+1)*size : (i+1)*size]
+}
+
+// Replaces the records between i and j (exclusive) with the given elements
+func (s RecordSlice[T]) splice(i, j int, elems []T) Slice[T] {
+	checkRange(i, j, s.Len())
+	size := s.codec.Size()
+
+	// Make sure there is room for the new records
+	newLen := len(s.data) + (len(elems)-(j-i))*size
+	if newLen > cap(s.data) {
+		s.data = s.grow(s.data, newLen)
+	}
+	// Move the records after the range
+	data := s.data[:newLen]
+	copy(data[(i+len(elems))*size:], s.data[j*size:])
+	s.data = data
+
+	for k, elem := range elems {
+		s.codec.Encode(s.record(i+k), elem)
+	}
+	return s
+}
+
+func (s RecordSlice[T]) Append(elems ...T) Slice[T] {
+	return s.splice(s.Len(), s.Len(), elems)
+}
+
+func (s RecordSlice[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	return s.Append(elems.ToGoSlice()...)
+}
+
+func (s RecordSlice[T]) Prepend(elems ...T) Slice[T] {
+	return s.splice(0, 0, elems)
+}
+
+func (s RecordSlice[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return s.Prepend(elems.ToGoSlice()...)
+}
+
+func (s RecordSlice[T]) Slice(i, j int) Slice[T] {
+	size := s.codec.Size()
+	s.data = s.data[i*size : j*size]
+	return s
+}
+
+func (s RecordSlice[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, nil)
+}
+
+func (s RecordSlice[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, nil)
+}
+
+func (s RecordSlice[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s RecordSlice[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems.ToGoSlice())
+}
+
+func (s RecordSlice[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, elems)
+}
+
+func (s RecordSlice[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	return s.codec.Decode(s.record(i))
+}
+
+func (s RecordSlice[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	s.codec.Encode(s.record(i), elem)
+}
+
+type recordSliceIterator[T any] struct {
+	slice RecordSlice[T]
+	index int
+}
+
+func (i *recordSliceIterator[T]) HasNext() bool {
+	return i.index+1 < i.slice.Len()
+}
+
+func (i *recordSliceIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.index++
+		return true
+	}
+	return false
+}
+
+func (i *recordSliceIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *recordSliceIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *recordSliceIterator[T]) Get() T {
+	return i.slice.Get(i.index)
+}
+
+func (i *recordSliceIterator[T]) Set(elem T) {
+	i.slice.Set(i.index, elem)
+}
+
+func (i *recordSliceIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *recordSliceIterator[T]) Seek(index int) {
+	if index < -1 || index > i.slice.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+}
+
+func (i *recordSliceIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *recordSliceIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to. Like RecordSlice.Insert, the records after the iterator are moved
+func (i *recordSliceIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.slice = i.slice.Insert(i.index, elem).(RecordSlice[T])
+	i.index++
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to. Like RecordSlice.Insert, the records after the iterator are moved
+func (i *recordSliceIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.slice.Len() {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.slice = i.slice.Insert(i.index+1, elem).(RecordSlice[T])
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element. Like RecordSlice.Erase, the records after
+// the iterator are moved
+func (i *recordSliceIterator[T]) Remove() {
+	i.slice = i.slice.Erase(i.index).(RecordSlice[T])
+	i.index--
+}
+
+// Slice gets the slice the iterator is iterating over, including the changes
+// made by the iterator
+func (i *recordSliceIterator[T]) Slice() Slice[T] {
+	return i.slice
+}
+
+func (s RecordSlice[T]) IterStart() Iterator[T] {
+	return &recordSliceIterator[T]{slice: s, index: -1}
+}
+
+func (s RecordSlice[T]) IterEnd() Iterator[T] {
+	return &recordSliceIterator[T]{slice: s, index: s.Len()}
+}
+
+func (s RecordSlice[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s RecordSlice[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+// DeepCopy copies the records into memory
+func (s RecordSlice[T]) DeepCopy() Slice[T] {
+	data := make([]byte, len(s.data))
+	copy(data, s.data)
+	return RecordSlice[T]{data: data, codec: s.codec, grow: growBytes}
+}
+
+func (s RecordSlice[T]) Len() int {
+	return len(s.data) / s.codec.Size()
+}
+
+func (s RecordSlice[T]) Cap() int {
+	return cap(s.data) / s.codec.Size()
+}
+
+func (s RecordSlice[T]) ToGoSlice() []T {
+	return ToGoSlice[T](s)
+}
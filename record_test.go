@@ -0,0 +1,192 @@
+package slice
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A Codec for ints, as 64-bit little endian numbers
+type intCodec struct{}
+
+func (intCodec) Size() int {
+	return 8
+}
+
+func (intCodec) Encode(record []byte, elem int) {
+	binary.LittleEndian.PutUint64(record, uint64(elem))
+}
+
+func (intCodec) Decode(record []byte) int {
+	return int(binary.LittleEndian.Uint64(record))
+}
+
+// Encodes the given elements as records
+func intRecords(elems ...int) []byte {
+	data := make([]byte, 8*len(elems))
+	for i, elem := range elems {
+		intCodec{}.Encode(data[8*i:], elem)
+	}
+	return data
+}
+
+func TestRecordSlice_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceAppendTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceAppendTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSlicePrependTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSlicePrependTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceInsertTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceInsertTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceSliceTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceSliceTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceEraseTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceEraseTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceReplaceTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceReplaceTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceIterTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceIterTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceReverseIterTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceReverseIterTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceRandomAccessIterTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceRandomAccessIterTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestRecordSlice_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceMutableIterTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceMutableIterTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+type testReading struct {
+	Sensor uint16
+	Value  float32
+}
+
+func (r testReading) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 6)
+	binary.BigEndian.PutUint16(data, r.Sensor)
+	binary.BigEndian.PutUint32(data[2:], uint32(r.Value*100))
+	return data, nil
+}
+
+func (r *testReading) UnmarshalBinary(data []byte) error {
+	if len(data) != 6 {
+		return errors.New("invalid reading")
+	}
+	r.Sensor = binary.BigEndian.Uint16(data)
+	r.Value = float32(binary.BigEndian.Uint32(data[2:])) / 100
+	return nil
+}
+
+func TestRecordSlice_Codecs(t *testing.T) {
+	readings := []testReading{{1, 0.5}, {2, 1.25}, {3, 2}}
+	for _, codec := range []Codec[testReading]{
+		MarshalerCodec[testReading](6),
+		BinaryCodec[testReading](binary.LittleEndian),
+	} {
+		s := EmptyRecordSlice[testReading](0, 0, codec).Append(readings...)
+		assert.Equal(t, 3, s.Len())
+		assert.Len(t, s.(RecordSlice[testReading]).Bytes(), 3*codec.Size())
+		assert.Equal(t, readings, s.ToGoSlice())
+
+		s.Set(1, testReading{4, 3})
+		assert.Equal(t, testReading{4, 3}, s.Get(1))
+	}
+
+	assert.Panics(t, func() {
+		BinaryCodec[[]int](binary.LittleEndian)
+	})
+	assert.Panics(t, func() {
+		MarshalerCodec[testReading](4).Encode(make([]byte, 4), testReading{})
+	})
+}
+
+func TestRecordSlice_Bytes(t *testing.T) {
+	// Records should be decoded and encoded in place
+	data := intRecords(1, 2, 3)
+	s := RecordSliceFrom[int](data, intCodec{}, nil)
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+	s.Set(0, 4)
+	assert.Equal(t, intRecords(4, 2, 3), data)
+
+	// The spare capacity should be used before growing
+	grown := 0
+	grow := func(data []byte, cap int) []byte {
+		grown++
+		return growBytes(data, cap)
+	}
+	s = RecordSliceFrom[int](make([]byte, 0, 16), intCodec{}, grow)
+	s = s.Append(1, 2)
+	assert.Equal(t, 0, grown)
+	s = s.Append(3)
+	assert.Equal(t, 1, grown)
+	assert.Equal(t, intRecords(1, 2, 3), s.(RecordSlice[int]).Bytes())
+
+	assert.Panics(t, func() {
+		RecordSliceFrom[int](make([]byte, 7), intCodec{}, nil)
+	})
+}
+
+// BENCHMARKING
+
+func BenchmarkRecordSlice_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
+
+func BenchmarkRecordSlice_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
+
+func BenchmarkRecordSlice_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
+
+func BenchmarkRecordSlice_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
+
+func BenchmarkRecordSlice_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
@@ -0,0 +1,355 @@
+package slice
+
+import "fmt"
+
+// RingMode is what a Ring does when an element is added while it's full
+type RingMode int
+
+const (
+	// RingOverwrite makes a full Ring overwrite its oldest elements. Appending
+	// overwrites the first elements, and prepending overwrites the last
+	// elements
+	RingOverwrite RingMode = iota
+
+	// RingReject makes a full Ring refuse the write, so the slice is returned
+	// unchanged if all the elements don't fit
+	RingReject
+)
+
+// Ring is a Slice type with a fixed capacity, implemented as a circular
+// buffer. Appending, prepending and getting elements are all O(1). Slicing
+// the start off a Ring frees the space for new elements, so a Ring works well
+// as a bounded buffer of recent elements. Like a Go slice, slices created from
+// a Ring share its buffer, so adding elements to one slice can overwrite the
+// elements of another
+type Ring[T any] struct {
+	buf []T
+	// The index in buf of the first element
+	start int
+	len   int
+	mode  RingMode
+}
+
+// EmptyRing creates an empty Ring Slice, with the given capacity. mode is what
+// the Ring does when it's full
+func EmptyRing[T any](cap int, mode RingMode) Slice[T] {
+	return Ring[T]{buf: make([]T, cap), mode: mode}
+}
+
+// RingFrom creates a Ring Slice from a Go slice, with the given capacity. If
+// there are more elements than the capacity, they're handled according to mode
+func RingFrom[T any](elems []T, cap int, mode RingMode) Slice[T] {
+	return EmptyRing[T](cap, mode).Append(elems...)
+}
+
+// Gets the index in buf of the element at the given index, which can be
+// outside the slice
+func (s Ring[T]) physical(i int) int {
+	i = (s.start + i) % len(s.buf)
+	if i < 0 {
+		i += len(s.buf)
+	}
+	return i
+}
+
+// Mode gets what the Ring does when it's full
+func (s Ring[T]) Mode() RingMode {
+	return s.mode
+}
+
+func (s Ring[T]) Append(elems ...T) Slice[T] {
+	if s.len+len(elems) > len(s.buf) {
+		if s.mode == RingReject {
+			return s
+		}
+		// Only the last elements would be kept
+		if len(elems) > len(s.buf) {
+			elems = elems[len(elems)-len(s.buf):]
+		}
+	}
+
+	for _, elem := range elems {
+		s.buf[s.physical(s.len)] = elem
+		// If the oldest element was overwritten
+		if s.len == len(s.buf) {
+			s.start = s.physical(1)
+		} else {
+			s.len++
+		}
+	}
+	return s
+}
+
+func (s Ring[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	return s.Append(elems.ToGoSlice()...)
+}
+
+func (s Ring[T]) Prepend(elems ...T) Slice[T] {
+	if s.len+len(elems) > len(s.buf) {
+		if s.mode == RingReject {
+			return s
+		}
+		// Only the first elements would be kept
+		if len(elems) > len(s.buf) {
+			elems = elems[:len(s.buf)]
+		}
+	}
+
+	for i := len(elems) - 1; i >= 0; i-- {
+		// If the ring is full, this overwrites the last element
+		s.start = s.physical(-1)
+		s.buf[s.start] = elems[i]
+		if s.len < len(s.buf) {
+			s.len++
+		}
+	}
+	return s
+}
+
+func (s Ring[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return s.Prepend(elems.ToGoSlice()...)
+}
+
+// Replaces the elements between i and j (exclusive) with the given elements.
+// Only the elements between the replaced elements and the closest end of the
+// slice are moved
+func (s Ring[T]) splice(i, j int, elems []T) Slice[T] {
+	checkRange(i, j, s.len)
+
+	newLen := s.len - (j - i) + len(elems)
+	// If the elements don't fit, create the new elements then keep the last
+	// elements that fit
+	if newLen > len(s.buf) {
+		if s.mode == RingReject {
+			return s
+		}
+		all := make([]T, 0, newLen)
+		all = append(all, s.Slice(0, i).ToGoSlice()...)
+		all = append(all, elems...)
+		all = append(all, s.Slice(j, s.len).ToGoSlice()...)
+		s.len = 0
+		return s.Append(all...)
+	}
+
+	// The number of elements the slice grows by
+	grow := newLen - s.len
+
+	// If there are fewer elements before i than from j onwards, move the
+	// elements before i backwards
+	if i < s.len-j {
+		if grow > 0 {
+			for k := 0; k < i; k++ {
+				s.buf[s.physical(k-grow)] = s.buf[s.physical(k)]
+			}
+		} else {
+			for k := i - 1; k >= 0; k-- {
+				s.buf[s.physical(k-grow)] = s.buf[s.physical(k)]
+			}
+		}
+		s.start = s.physical(-grow)
+	} else {
+		// Otherwise move the elements from j onwards forwards
+		if grow > 0 {
+			for k := s.len - 1; k >= j; k-- {
+				s.buf[s.physical(k+grow)] = s.buf[s.physical(k)]
+			}
+		} else {
+			for k := j; k < s.len; k++ {
+				s.buf[s.physical(k+grow)] = s.buf[s.physical(k)]
+			}
+		}
+	}
+	s.len = newLen
+
+	for k, elem := range elems {
+		s.buf[s.physical(i+k)] = elem
+	}
+	return s
+}
+
+func (s Ring[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, nil)
+}
+
+func (s Ring[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, nil)
+}
+
+func (s Ring[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s Ring[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems.ToGoSlice())
+}
+
+func (s Ring[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, elems)
+}
+
+// Slice creates a new slice that shares the buffer with the original slice.
+// The capacity of the new slice is the same as the original, so the space
+// before i can be used by elements added onto the new slice
+func (s Ring[T]) Slice(i, j int) Slice[T] {
+	checkRange(i, j, len(s.buf))
+	if len(s.buf) > 0 {
+		s.start = s.physical(i)
+	}
+	s.len = j - i
+	return s
+}
+
+func (s Ring[T]) Get(i int) T {
+	if i < 0 || i >= s.len {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	return s.buf[s.physical(i)]
+}
+
+func (s Ring[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.len {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	s.buf[s.physical(i)] = elem
+}
+
+type ringIterator[T any] struct {
+	slice Ring[T]
+	index int
+}
+
+func (i *ringIterator[T]) HasNext() bool {
+	return i.index+1 < i.slice.len
+}
+
+func (i *ringIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.index++
+		return true
+	}
+	return false
+}
+
+func (i *ringIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *ringIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.index--
+		return true
+	}
+	return false
+}
+
+func (i *ringIterator[T]) Get() T {
+	return i.slice.Get(i.index)
+}
+
+func (i *ringIterator[T]) Set(elem T) {
+	i.slice.Set(i.index, elem)
+}
+
+func (i *ringIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *ringIterator[T]) Seek(index int) {
+	if index < -1 || index > i.slice.len {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+}
+
+func (i *ringIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *ringIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to. If the ring is full, the element is handled according to its
+// mode
+func (i *ringIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	length := i.slice.len
+	i.slice = i.slice.Insert(i.index, elem).(Ring[T])
+	// If the element was inserted, the iterator's element moved forwards.
+	// Otherwise either the write was rejected, or the first element was
+	// overwritten, so the iterator's element didn't move
+	if i.slice.len > length {
+		i.index++
+	}
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to. If the ring is full, the element is handled according to its
+// mode
+func (i *ringIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.slice.len {
+		panic("slice: can't insert after the end of the slice")
+	}
+	length := i.slice.len
+	i.slice = i.slice.Insert(i.index+1, elem).(Ring[T])
+	// If the first element was overwritten, the iterator's element moved
+	// backwards
+	if i.slice.len == length && i.slice.mode == RingOverwrite {
+		i.index--
+	}
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element
+func (i *ringIterator[T]) Remove() {
+	i.slice = i.slice.Erase(i.index).(Ring[T])
+	i.index--
+}
+
+// Slice gets the slice the iterator is iterating over, including the changes
+// made by the iterator
+func (i *ringIterator[T]) Slice() Slice[T] {
+	return i.slice
+}
+
+func (s Ring[T]) IterStart() Iterator[T] {
+	return &ringIterator[T]{slice: s, index: -1}
+}
+
+func (s Ring[T]) IterEnd() Iterator[T] {
+	return &ringIterator[T]{slice: s, index: s.len}
+}
+
+func (s Ring[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s Ring[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+func (s Ring[T]) DeepCopy() Slice[T] {
+	return RingFrom(s.ToGoSlice(), len(s.buf), s.mode)
+}
+
+func (s Ring[T]) Len() int {
+	return s.len
+}
+
+func (s Ring[T]) Cap() int {
+	return len(s.buf)
+}
+
+func (s Ring[T]) ToGoSlice() []T {
+	slice := make([]T, 0, atLeast(1, s.len))
+	// The elements are in at most two parts of the buffer
+	end := s.start + s.len
+	if end <= len(s.buf) {
+		return append(slice, s.buf[s.start:end]...)
+	}
+	slice = append(slice, s.buf[s.start:]...)
+	return append(slice, s.buf[:end-len(s.buf)]...)
+}
@@ -0,0 +1,182 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRing_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceAppendTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceAppendTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSlicePrependTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSlicePrependTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceInsertTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceInsertTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceSliceTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceSliceTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceEraseTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceEraseTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceReplaceTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceReplaceTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceIterTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceIterTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceReverseIterTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceReverseIterTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceRandomAccessIterTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceRandomAccessIterTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceMutableIterTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceMutableIterTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestRing_Overwrite(t *testing.T) {
+	s := RingFrom([]int{1, 2, 3}, 3, RingOverwrite)
+	s = s.Append(4, 5)
+	assert.Equal(t, []int{3, 4, 5}, s.ToGoSlice())
+	commonSliceLenTest(t, s, 3)
+	assert.Equal(t, 3, s.Cap())
+
+	s = s.Prepend(2)
+	assert.Equal(t, []int{2, 3, 4}, s.ToGoSlice())
+
+	s = s.Append(6, 7, 8, 9)
+	assert.Equal(t, []int{7, 8, 9}, s.ToGoSlice())
+
+	s = s.Prepend(4, 5, 6, 7)
+	assert.Equal(t, []int{4, 5, 6}, s.ToGoSlice())
+
+	// Inserting into a full ring should overwrite the first element
+	s = s.Insert(2, 0)
+	assert.Equal(t, []int{5, 0, 6}, s.ToGoSlice())
+
+	iter := s.IterStart().(MutableIterator[int])
+	iter.(RandomAccessIterator[int]).Seek(1)
+	iter.InsertAfter(1)
+	assert.Equal(t, []int{0, 1, 6}, iter.Slice().ToGoSlice())
+	assert.Equal(t, 0, iter.Get())
+}
+
+func TestRing_Reject(t *testing.T) {
+	s := RingFrom([]int{1, 2, 3, 4}, 3, RingReject)
+	commonSliceLenTest(t, s, 0)
+
+	s = s.Append(1, 2)
+	s = s.Append(3, 4)
+	assert.Equal(t, []int{1, 2}, s.ToGoSlice())
+	s = s.Prepend(0)
+	assert.Equal(t, []int{0, 1, 2}, s.ToGoSlice())
+	s = s.Prepend(-1)
+	s = s.Insert(1, -1)
+	assert.Equal(t, []int{0, 1, 2}, s.ToGoSlice())
+
+	iter := s.IterStart().(MutableIterator[int])
+	iter.Next()
+	iter.InsertBefore(-1)
+	iter.InsertAfter(-1)
+	assert.Equal(t, []int{0, 1, 2}, iter.Slice().ToGoSlice())
+	assert.Equal(t, 0, iter.Get())
+
+	// Slicing off the start should free up space
+	s = s.Slice(1, s.Len())
+	s = s.Append(3)
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+}
+
+func TestRing_Splice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, mode := range []RingMode{RingOverwrite, RingReject} {
+		expected := make([]int, 0)
+		s := EmptyRing[int](50, mode)
+		for k := 0; k < 1000; k++ {
+			i := r.Intn(len(expected) + 1)
+			j := i + r.Intn(len(expected)-i+1)
+			elems := make([]int, r.Intn(10))
+			for e := range elems {
+				elems[e] = k
+			}
+			s = s.Replace(i, j, elems...)
+
+			// Work out the expected elements
+			replaced := append(append(append([]int{}, expected[:i]...), elems...), expected[j:]...)
+			if len(replaced) <= s.Cap() {
+				expected = replaced
+			} else if mode == RingOverwrite {
+				expected = replaced[len(replaced)-s.Cap():]
+			}
+			assert.Equal(t, expected, s.ToGoSlice())
+
+			// Occasionally slice off the start of the ring
+			if r.Intn(10) == 0 {
+				n := r.Intn(len(expected) + 1)
+				s = s.Slice(n, s.Len())
+				expected = expected[n:]
+			}
+		}
+	}
+}
+
+// BENCHMARKING
+
+func BenchmarkRing_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptyRing[int](benchmarkMinSliceLen, RingOverwrite))
+}
+
+func BenchmarkRing_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptyRing[int](benchmarkMinSliceLen, RingOverwrite))
+}
+
+func BenchmarkRing_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptyRing[int](benchmarkMinSliceLen, RingOverwrite))
+}
+
+func BenchmarkRing_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptyRing[int](benchmarkMinSliceLen, RingOverwrite))
+}
+
+func BenchmarkRing_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptyRing[int](benchmarkMinSliceLen, RingOverwrite))
+}
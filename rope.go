@@ -0,0 +1,510 @@
+package slice
+
+import "fmt"
+
+// The minimum and maximum number of elements in a Rope leaf. Only the root can
+// have fewer than the minimum
+const (
+	ropeMinLeaf = 32
+	ropeMaxLeaf = 2 * ropeMinLeaf
+)
+
+// The minimum and maximum number of children of a Rope branch. Only the root
+// can have fewer than the minimum
+const (
+	ropeMinChildren = 4
+	ropeMaxChildren = 2 * ropeMinChildren
+)
+
+// A node of a Rope tree. Branch nodes only have children, and leaf nodes only
+// have elements. Every leaf is at the same depth. Apart from the elements,
+// nodes are never modified after they're created
+type ropeNode[T any] struct {
+	children []*ropeNode[T]
+	elems    []T
+	// The height of the node, where leaves are 0
+	height int
+	// The number of elements under the node
+	len int
+}
+
+func newRopeLeaf[T any](elems []T) *ropeNode[T] {
+	return &ropeNode[T]{elems: elems, len: len(elems)}
+}
+
+func newRopeBranch[T any](children ...*ropeNode[T]) *ropeNode[T] {
+	node := &ropeNode[T]{children: children, height: children[0].height + 1}
+	for _, child := range children {
+		node.len += child.len
+	}
+	return node
+}
+
+// Gets whether the node has enough elements (or children) to not be the root
+func (n *ropeNode[T]) full() bool {
+	if n.height == 0 {
+		return len(n.elems) >= ropeMinLeaf
+	}
+	return len(n.children) >= ropeMinChildren
+}
+
+// Calls f with the elements of each leaf under the node, in order, until f
+// returns false. Returns false if f did
+func (n *ropeNode[T]) each(f func([]T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.height == 0 {
+		return f(n.elems)
+	}
+	for _, child := range n.children {
+		if !child.each(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Splits the given number of items into groups of at most max items, that are
+// as close to the same size as possible
+func ropeGroups(n, max int) []int {
+	count := (n + max - 1) / max
+	groups := make([]int, count)
+	for i := range groups {
+		groups[i] = n / count
+		if i < n%count {
+			groups[i]++
+		}
+	}
+	return groups
+}
+
+// Builds a balanced tree from a Go slice. The elements are copied
+func buildRope[T any](elems []T) *ropeNode[T] {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	// Create the leaves
+	nodes := make([]*ropeNode[T], 0, (len(elems)+ropeMaxLeaf-1)/ropeMaxLeaf)
+	for _, size := range ropeGroups(len(elems), ropeMaxLeaf) {
+		leaf := make([]T, size)
+		copy(leaf, elems)
+		elems = elems[size:]
+		nodes = append(nodes, newRopeLeaf(leaf))
+	}
+
+	// Then group the nodes together until there is only the root
+	for len(nodes) > 1 {
+		parents := make([]*ropeNode[T], 0, len(ropeGroups(len(nodes), ropeMaxChildren)))
+		for _, size := range ropeGroups(len(nodes), ropeMaxChildren) {
+			children := make([]*ropeNode[T], size)
+			copy(children, nodes)
+			nodes = nodes[size:]
+			parents = append(parents, newRopeBranch(children...))
+		}
+		nodes = parents
+	}
+	return nodes[0]
+}
+
+// Creates a node from the children in a and b. If there are too many children,
+// they're split between two nodes, under a new parent
+func mergeRopeNodes[T any](a, b []*ropeNode[T]) *ropeNode[T] {
+	children := make([]*ropeNode[T], 0, len(a)+len(b))
+	children = append(children, a...)
+	children = append(children, b...)
+	if len(children) <= ropeMaxChildren {
+		return newRopeBranch(children...)
+	}
+
+	split := len(children) - ropeMinChildren
+	if split > ropeMaxChildren {
+		split = ropeMaxChildren
+	}
+	return newRopeBranch(newRopeBranch(children[:split]...), newRopeBranch(children[split:]...))
+}
+
+// Joins two leaves. If either leaf is too small, the elements are copied into
+// one (or two) new leaves
+func mergeRopeLeaves[T any](a, b *ropeNode[T]) *ropeNode[T] {
+	if a.full() && b.full() {
+		return newRopeBranch(a, b)
+	}
+
+	elems := make([]T, 0, len(a.elems)+len(b.elems))
+	elems = append(elems, a.elems...)
+	elems = append(elems, b.elems...)
+	if len(elems) <= ropeMaxLeaf {
+		return newRopeLeaf(elems)
+	}
+	mid := len(elems) / 2
+	return newRopeBranch(newRopeLeaf(elems[:mid:mid]), newRopeLeaf(elems[mid:]))
+}
+
+// Joins two trees, so that the elements of b are after the elements of a. Only
+// the nodes along the edges where the trees meet are copied
+func concatRope[T any](a, b *ropeNode[T]) *ropeNode[T] {
+	if a == nil || a.len == 0 {
+		return b
+	}
+	if b == nil || b.len == 0 {
+		return a
+	}
+
+	switch {
+	// If a is shorter, join it onto the left edge of b
+	case a.height < b.height:
+		if a.height == b.height-1 && a.full() {
+			return mergeRopeNodes([]*ropeNode[T]{a}, b.children)
+		}
+		node := concatRope(a, b.children[0])
+		if node.height == b.height-1 {
+			return mergeRopeNodes([]*ropeNode[T]{node}, b.children[1:])
+		}
+		return mergeRopeNodes(node.children, b.children[1:])
+
+	// If b is shorter, join it onto the right edge of a
+	case a.height > b.height:
+		last := len(a.children) - 1
+		if b.height == a.height-1 && b.full() {
+			return mergeRopeNodes(a.children, []*ropeNode[T]{b})
+		}
+		node := concatRope(a.children[last], b)
+		if node.height == a.height-1 {
+			return mergeRopeNodes(a.children[:last], []*ropeNode[T]{node})
+		}
+		return mergeRopeNodes(a.children[:last], node.children)
+
+	default:
+		if a.full() && b.full() {
+			return newRopeBranch(a, b)
+		}
+		if a.height == 0 {
+			return mergeRopeLeaves(a, b)
+		}
+		return mergeRopeNodes(a.children, b.children)
+	}
+}
+
+// Removes any branches from the top of the tree that only have one child
+func trimRope[T any](n *ropeNode[T]) *ropeNode[T] {
+	for n != nil && n.height > 0 && len(n.children) == 1 {
+		n = n.children[0]
+	}
+	return n
+}
+
+// Splits the tree into the elements before the given index and the elements
+// from the index onwards. Leaves that are split share their elements between
+// the two trees
+func splitRope[T any](n *ropeNode[T], i int) (*ropeNode[T], *ropeNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if i == 0 {
+		return nil, n
+	}
+	if i == n.len {
+		return n, nil
+	}
+
+	if n.height == 0 {
+		return newRopeLeaf(n.elems[:i:i]), newRopeLeaf(n.elems[i:])
+	}
+
+	// Find the child with the index
+	k := 0
+	for i >= n.children[k].len {
+		i -= n.children[k].len
+		k++
+	}
+	left, right := splitRope(n.children[k], i)
+
+	// Join the halves of the child onto the children either side of it
+	if k > 0 {
+		children := make([]*ropeNode[T], k)
+		copy(children, n.children[:k])
+		left = concatRope(newRopeBranch(children...), left)
+	}
+	if k+1 < len(n.children) {
+		children := make([]*ropeNode[T], len(n.children)-k-1)
+		copy(children, n.children[k+1:])
+		right = concatRope(right, newRopeBranch(children...))
+	}
+	return trimRope(left), trimRope(right)
+}
+
+// Rope is a Slice type, implemented as a B-tree of "leaves" of elements. Like a
+// Go slice, slices created from a Rope share its elements, so setting an
+// element can modify other slices. Get and Set are O(log n), and so are
+// inserting, erasing, splitting and joining anywhere in the slice, so a Rope
+// suits large slices that are edited in the middle
+type Rope[T any] struct {
+	root *ropeNode[T]
+}
+
+// EmptyRope creates an empty Rope Slice
+func EmptyRope[T any]() Slice[T] {
+	return Rope[T]{}
+}
+
+// RopeFrom creates a Rope Slice from a Go slice. The elements are copied
+func RopeFrom[T any](elems []T) Slice[T] {
+	return Rope[T]{root: buildRope(elems)}
+}
+
+// Gets the root of the given slice's tree, building one if the slice isn't a
+// Rope
+func ropeRoot[T any](s Slice[T]) *ropeNode[T] {
+	if rope, ok := s.(Rope[T]); ok {
+		return rope.root
+	}
+	return buildRope(s.ToGoSlice())
+}
+
+// Gets the leaf that contains the element at the given index, and the index of
+// the leaf's first element
+func (s Rope[T]) leaf(i int) ([]T, int) {
+	start := 0
+	node := s.root
+	for node.height > 0 {
+		k := 0
+		for i-start >= node.children[k].len {
+			start += node.children[k].len
+			k++
+		}
+		node = node.children[k]
+	}
+	return node.elems, start
+}
+
+func (s Rope[T]) Append(elems ...T) Slice[T] {
+	return Rope[T]{root: concatRope(s.root, buildRope(elems))}
+}
+
+// AppendSlice joins the given slice onto the end of this slice. If the given
+// slice is a Rope, this is O(log n) and the new slice shares its elements
+func (s Rope[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	return Rope[T]{root: concatRope(s.root, ropeRoot(elems))}
+}
+
+func (s Rope[T]) Prepend(elems ...T) Slice[T] {
+	return Rope[T]{root: concatRope(buildRope(elems), s.root)}
+}
+
+// PrependSlice joins the given slice onto the start of this slice. If the
+// given slice is a Rope, this is O(log n) and the new slice shares its elements
+func (s Rope[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return Rope[T]{root: concatRope(ropeRoot(elems), s.root)}
+}
+
+// Split splits the slice into the elements before the given index, and the
+// elements from the index onwards, in O(log n) time
+func (s Rope[T]) Split(i int) (Slice[T], Slice[T]) {
+	checkRange(i, i, s.Len())
+	left, right := splitRope(s.root, i)
+	return Rope[T]{root: left}, Rope[T]{root: right}
+}
+
+// Replaces the elements between i and j (exclusive) with the given tree
+func (s Rope[T]) splice(i, j int, elems *ropeNode[T]) Slice[T] {
+	checkRange(i, j, s.Len())
+	left, rest := splitRope(s.root, i)
+	_, right := splitRope(rest, j-i)
+	return Rope[T]{root: concatRope(concatRope(left, elems), right)}
+}
+
+func (s Rope[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, nil)
+}
+
+func (s Rope[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, nil)
+}
+
+func (s Rope[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, buildRope(elems))
+}
+
+func (s Rope[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, ropeRoot(elems))
+}
+
+func (s Rope[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, buildRope(elems))
+}
+
+// Slice creates a new slice that shares the elements between i and j
+// (exclusive) with the original slice. As a Rope's capacity is its length, j
+// can't be more than the length
+func (s Rope[T]) Slice(i, j int) Slice[T] {
+	checkRange(i, j, s.Len())
+	left, _ := splitRope(s.root, j)
+	_, right := splitRope(left, i)
+	return Rope[T]{root: right}
+}
+
+func (s Rope[T]) Get(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	leaf, start := s.leaf(i)
+	return leaf[i-start]
+}
+
+func (s Rope[T]) Set(i int, elem T) {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	leaf, start := s.leaf(i)
+	leaf[i-start] = elem
+}
+
+type ropeIterator[T any] struct {
+	slice Rope[T]
+	index int
+	// The leaf that contains the current element, and the index of its first
+	// element
+	leaf      []T
+	leafStart int
+}
+
+func (i *ropeIterator[T]) HasNext() bool {
+	return i.index+1 < i.slice.Len()
+}
+
+func (i *ropeIterator[T]) Next() bool {
+	if i.HasNext() {
+		i.Seek(i.index + 1)
+		return true
+	}
+	return false
+}
+
+func (i *ropeIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *ropeIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		i.Seek(i.index - 1)
+		return true
+	}
+	return false
+}
+
+func (i *ropeIterator[T]) Get() T {
+	return i.leaf[i.index-i.leafStart]
+}
+
+func (i *ropeIterator[T]) Set(elem T) {
+	i.leaf[i.index-i.leafStart] = elem
+}
+
+func (i *ropeIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *ropeIterator[T]) Seek(index int) {
+	length := i.slice.Len()
+	if index < -1 || index > length {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+
+	// Only find the leaf if the element is in a different leaf
+	if index == -1 || index == length {
+		i.leaf, i.leafStart = nil, 0
+	} else if index < i.leafStart || index >= i.leafStart+len(i.leaf) {
+		i.leaf, i.leafStart = i.slice.leaf(index)
+	}
+}
+
+func (i *ropeIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *ropeIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to, in O(log n) time
+func (i *ropeIterator[T]) InsertBefore(elem T) {
+	index := i.index
+	if index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.slice = i.slice.Insert(index, elem).(Rope[T])
+	i.leaf = nil
+	i.Seek(index + 1)
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to, in O(log n) time
+func (i *ropeIterator[T]) InsertAfter(elem T) {
+	index := i.index
+	if index >= i.slice.Len() {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.slice = i.slice.Insert(index+1, elem).(Rope[T])
+	i.leaf = nil
+	i.Seek(index)
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element, in O(log n) time
+func (i *ropeIterator[T]) Remove() {
+	index := i.index
+	i.slice = i.slice.Erase(index).(Rope[T])
+	i.leaf = nil
+	i.Seek(index - 1)
+}
+
+// Slice gets the slice the iterator is iterating over, including the changes
+// made by the iterator
+func (i *ropeIterator[T]) Slice() Slice[T] {
+	return i.slice
+}
+
+func (s Rope[T]) IterStart() Iterator[T] {
+	return &ropeIterator[T]{slice: s, index: -1}
+}
+
+func (s Rope[T]) IterEnd() Iterator[T] {
+	return &ropeIterator[T]{slice: s, index: s.Len()}
+}
+
+func (s Rope[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s Rope[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+func (s Rope[T]) DeepCopy() Slice[T] {
+	return RopeFrom(s.ToGoSlice())
+}
+
+func (s Rope[T]) Len() int {
+	if s.root == nil {
+		return 0
+	}
+	return s.root.len
+}
+
+// Cap returns the length of the slice, as a Rope doesn't have spare capacity
+func (s Rope[T]) Cap() int {
+	return s.Len()
+}
+
+func (s Rope[T]) ToGoSlice() []T {
+	slice := make([]T, 0, atLeast(1, s.Len()))
+	s.root.each(func(leaf []T) bool {
+		slice = append(slice, leaf...)
+		return true
+	})
+	return slice
+}
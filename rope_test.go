@@ -0,0 +1,169 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRope_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptyRope[int]())
+	commonSliceAppendTest(t, RopeFrom([]int{1}))
+	commonSliceAppendTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptyRope[int]())
+	commonSlicePrependTest(t, RopeFrom([]int{1}))
+	commonSlicePrependTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptyRope[int]())
+	commonSliceInsertTest(t, RopeFrom([]int{1}))
+	commonSliceInsertTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptyRope[int]())
+	commonSliceSliceTest(t, RopeFrom([]int{1}))
+	commonSliceSliceTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptyRope[int]())
+	commonSliceEraseTest(t, RopeFrom([]int{1}))
+	commonSliceEraseTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptyRope[int]())
+	commonSliceReplaceTest(t, RopeFrom([]int{1}))
+	commonSliceReplaceTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptyRope[int]())
+	commonSliceIterTest(t, RopeFrom([]int{1}))
+	commonSliceIterTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptyRope[int]())
+	commonSliceReverseIterTest(t, RopeFrom([]int{1}))
+	commonSliceReverseIterTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptyRope[int]())
+	commonSliceRandomAccessIterTest(t, RopeFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRope_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptyRope[int]())
+	commonSliceMutableIterTest(t, RopeFrom([]int{1}))
+	commonSliceMutableIterTest(t, RopeFrom([]int{1, 2}))
+}
+
+// Checks that every leaf is at the same depth, that the lengths are correct,
+// and that only the root is smaller than the minimum size
+func checkRopeNode(t *testing.T, n *ropeNode[int], root bool) {
+	if n.height == 0 {
+		assert.Equal(t, len(n.elems), n.len)
+		assert.LessOrEqual(t, len(n.elems), ropeMaxLeaf)
+		if !root {
+			assert.GreaterOrEqual(t, len(n.elems), 1)
+		}
+		return
+	}
+
+	assert.LessOrEqual(t, len(n.children), ropeMaxChildren)
+	if root {
+		assert.GreaterOrEqual(t, len(n.children), 2)
+	}
+	length := 0
+	for _, child := range n.children {
+		assert.Equal(t, n.height-1, child.height)
+		checkRopeNode(t, child, false)
+		length += child.len
+	}
+	assert.Equal(t, length, n.len)
+}
+
+func TestRope_Edits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expected := make([]int, 0)
+	s := EmptyRope[int]()
+	for k := 0; k < 2000; k++ {
+		i := r.Intn(len(expected) + 1)
+		j := i + r.Intn(len(expected)-i+1)
+		switch r.Intn(4) {
+		// Insert a run of elements
+		case 0, 1:
+			elems := make([]int, r.Intn(100))
+			for e := range elems {
+				elems[e] = k
+			}
+			s = s.Insert(i, elems...)
+			expected = append(expected[:i], append(elems, expected[i:]...)...)
+
+		// Erase a range of elements
+		case 2:
+			if i < j {
+				s = s.EraseRange(i, j-1)
+				expected = append(expected[:i], expected[j:]...)
+			}
+
+		// Split the slice and join it back together in the opposite order
+		case 3:
+			left, right := s.(Rope[int]).Split(i)
+			s = right.AppendSlice(left)
+			expected = append(append([]int{}, expected[i:]...), expected[:i]...)
+		}
+
+		if root := s.(Rope[int]).root; root != nil {
+			checkRopeNode(t, root, true)
+		}
+		commonSliceLenTest(t, s, len(expected))
+	}
+	assert.Equal(t, expected, s.ToGoSlice())
+
+	// Check the elements can be got and set
+	for i := range expected {
+		commonSliceGetTest(t, s, i, expected[i])
+		s.Set(i, -i)
+	}
+	for i := range expected {
+		commonSliceGetTest(t, s, i, -i)
+	}
+}
+
+// BENCHMARKING
+
+func BenchmarkRope_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptyRope[int]())
+}
+
+func BenchmarkRope_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptyRope[int]())
+}
+
+func BenchmarkRope_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptyRope[int]())
+}
+
+func BenchmarkRope_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptyRope[int]())
+}
+
+func BenchmarkRope_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptyRope[int]())
+}
@@ -0,0 +1,141 @@
+package slice
+
+// BinarySearch searches for target in a slice sorted in ascending order. It
+// returns the index where target was found, or the index where it would be
+// inserted, and whether it was found
+func BinarySearch[T Ordered](s Slice[T], target T) (int, bool) {
+	return BinarySearchFunc(s, target, compareOrdered[T])
+}
+
+// BinarySearchFunc is like BinarySearch, but uses cmp to compare the elements
+// with target. cmp(elem, target) should return a negative number when elem is
+// before target, a positive number when elem is after target and 0 when they
+// match. The slice must be sorted in the order cmp defines
+func BinarySearchFunc[T, E any](s Slice[T], target E, cmp func(T, E) int) (int, bool) {
+	i, iter := LowerBoundFunc(s, target, cmp)
+	return i, i < s.Len() && cmp(iter.Get(), target) == 0
+}
+
+// LowerBound searches for the first element that isn't less than target in a
+// slice sorted in ascending order. It returns the index of the element, and an
+// iterator pointed to it. If there is no such element, the index is the length
+// of the slice and the iterator is pointed to the end of the slice
+func LowerBound[T Ordered](s Slice[T], target T) (int, Iterator[T]) {
+	return LowerBoundFunc(s, target, compareOrdered[T])
+}
+
+// LowerBoundFunc is like LowerBound, but uses cmp to compare the elements with
+// target, in the same way as BinarySearchFunc
+func LowerBoundFunc[T, E any](s Slice[T], target E, cmp func(T, E) int) (int, Iterator[T]) {
+	return search(s, func(elem T) bool {
+		return cmp(elem, target) < 0
+	})
+}
+
+// UpperBound searches for the first element that is greater than target in a
+// slice sorted in ascending order. It returns the index of the element, and an
+// iterator pointed to it. If there is no such element, the index is the length
+// of the slice and the iterator is pointed to the end of the slice
+func UpperBound[T Ordered](s Slice[T], target T) (int, Iterator[T]) {
+	return UpperBoundFunc(s, target, compareOrdered[T])
+}
+
+// UpperBoundFunc is like UpperBound, but uses cmp to compare the elements with
+// target, in the same way as BinarySearchFunc
+func UpperBoundFunc[T, E any](s Slice[T], target E, cmp func(T, E) int) (int, Iterator[T]) {
+	return search(s, func(elem T) bool {
+		return cmp(elem, target) <= 0
+	})
+}
+
+// Finds the first element where before returns false, assuming before returns
+// true for every element up to that point. Returns the index of the element
+// and an iterator pointed to it
+func search[T any](s Slice[T], before func(T) bool) (int, Iterator[T]) {
+	switch s := s.(type) {
+	case Singly[T]:
+		return s.search(before)
+	case Doubly[T]:
+		return s.search(before)
+	}
+
+	// Get is cheap enough for a normal binary search
+	i, j := 0, s.Len()
+	for i < j {
+		mid := int(uint(i+j) >> 1)
+		if before(s.Get(mid)) {
+			i = mid + 1
+		} else {
+			j = mid
+		}
+	}
+	return i, iterAt(s, i)
+}
+
+// Binary searches the list, walking forwards from the start of the remaining
+// range rather than finding each node from the start of the list, so that at
+// most len nodes are visited
+func (s Singly[T]) search(before func(T) bool) (int, Iterator[T]) {
+	i, n := 0, s.len
+	var prev *singlyNode[T]
+	node := s.start
+	for n > 0 {
+		// Walk to the middle of the remaining range
+		half := n / 2
+		mid := node
+		for k := 0; k < half && mid != nil; k++ {
+			mid = mid.next
+		}
+
+		// If the element is before the search, search the second half
+		if before(mid.Get()) {
+			prev = mid
+			node = nil
+			if mid != nil {
+				node = mid.next
+			}
+			i += half + 1
+			n -= half + 1
+		} else {
+			n = half
+		}
+	}
+
+	if i == s.len {
+		return i, s.IterEnd()
+	}
+	return i, &singlyIterator[T]{list: s, node: node, prev: prev, index: i}
+}
+
+// Binary searches the list, walking forwards from the start of the remaining
+// range rather than finding each node from the start of the list, so that at
+// most len nodes are visited
+func (s Doubly[T]) search(before func(T) bool) (int, Iterator[T]) {
+	i, n := 0, s.len
+	node := s.start
+	for n > 0 {
+		// Walk to the middle of the remaining range
+		half := n / 2
+		mid := node
+		for k := 0; k < half && mid != nil; k++ {
+			mid = mid.next
+		}
+
+		// If the element is before the search, search the second half
+		if before(mid.Get()) {
+			node = nil
+			if mid != nil {
+				node = mid.next
+			}
+			i += half + 1
+			n -= half + 1
+		} else {
+			n = half
+		}
+	}
+
+	if i == s.len {
+		return i, s.IterEnd()
+	}
+	return i, &doublyIterator[T]{list: s, node: node, index: i}
+}
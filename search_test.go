@@ -0,0 +1,130 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceSearchTest(t *testing.T, s Slice[int]) {
+	s1 := s.Append(1, 3, 3, 3, 5, 7, 9)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	i, found := BinarySearch(s1, 5)
+	assert.Equal(t, 4, i)
+	assert.True(t, found)
+
+	i, found = BinarySearch(s1, 4)
+	assert.Equal(t, 4, i)
+	assert.False(t, found)
+
+	i, found = BinarySearch(s1, 10)
+	assert.Equal(t, 7, i)
+	assert.False(t, found)
+
+	i, found = BinarySearchFunc(s1, "7", func(elem int, target string) int {
+		return compareOrdered(string(rune('0'+elem)), target)
+	})
+	assert.Equal(t, 5, i)
+	assert.True(t, found)
+
+	i, iter := LowerBound(s1, 3)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, 3, iter.Get())
+	// The iterator should carry on from the result
+	assert.True(t, iter.Next())
+	assert.Equal(t, 3, iter.Get())
+
+	i, iter = LowerBound(s1, 0)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, 1, iter.Get())
+
+	i, iter = UpperBound(s1, 3)
+	assert.Equal(t, 4, i)
+	assert.Equal(t, 5, iter.Get())
+	assert.True(t, iter.Next())
+	assert.Equal(t, 7, iter.Get())
+
+	i, iter = UpperBound(s1, 9)
+	assert.Equal(t, 7, i)
+	assert.False(t, iter.HasNext())
+
+	i, _ = LowerBound(s.Slice(0, 0), 1)
+	assert.Equal(t, 0, i)
+}
+
+func TestWrapper_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptySlice[int](0, 0))
+	commonSliceSearchTest(t, Wrap([]int{1}))
+	commonSliceSearchTest(t, Wrap([]int{1, 2}))
+}
+
+func TestDistributed_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyDistributed[int](0, 2))
+	commonSliceSearchTest(t, DistributedFrom([]int{1}))
+	commonSliceSearchTest(t, DistributedFrom([]int{1, 2}))
+}
+
+func TestSingly_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptySingly[int]())
+	commonSliceSearchTest(t, SinglyFrom([]int{1}))
+	commonSliceSearchTest(t, SinglyFrom([]int{1, 2}))
+
+	// Searching a list that an edit was made from shouldn't walk past its nodes
+	edited := SinglyFrom([]int{1, 2, 3, 4})
+	edited.Erase(1)
+	assert.NotPanics(t, func() {
+		BinarySearch(edited, 5)
+		BinarySearch(edited, 0)
+	})
+}
+
+func TestDoubly_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyDoubly[int]())
+	commonSliceSearchTest(t, DoublyFrom([]int{1}))
+	commonSliceSearchTest(t, DoublyFrom([]int{1, 2}))
+
+	// Searching a list that an edit was made from shouldn't walk past its nodes
+	edited := DoublyFrom([]int{1, 2, 3, 4})
+	edited.Erase(1)
+	assert.NotPanics(t, func() {
+		BinarySearch(edited, 5)
+		BinarySearch(edited, 0)
+	})
+}
+
+func TestPersistent_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyPersistent[int]())
+	commonSliceSearchTest(t, PersistentFrom([]int{1}))
+	commonSliceSearchTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestRope_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyRope[int]())
+	commonSliceSearchTest(t, RopeFrom([]int{1}))
+	commonSliceSearchTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRing_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceSearchTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceSearchTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestGapBuffer_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyGapBuffer[int](0))
+	commonSliceSearchTest(t, GapBufferFrom([]int{1}))
+	commonSliceSearchTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestSkipList_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptySkipList[int]())
+	commonSliceSearchTest(t, SkipListFrom([]int{1}))
+	commonSliceSearchTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestRecordSlice_Search(t *testing.T) {
+	commonSliceSearchTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceSearchTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceSearchTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
@@ -0,0 +1,584 @@
+//go:build go1.23
+
+package slice
+
+import "iter"
+
+// WrapSeq creates a Wrapper Slice from the elements of a sequence
+func WrapSeq[T any](seq iter.Seq[T]) Slice[T] {
+	var s []T
+	for elem := range seq {
+		s = append(s, elem)
+	}
+	return Wrap(s)
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Wrapper[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, elem := range s {
+			if !yield(i, elem) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Wrapper[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range s {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Wrapper[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// DistributedFromSeq creates a Distributed Slice from the elements of a
+// sequence
+func DistributedFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := EmptyDistributed[T](0, 0)
+	for elem := range seq {
+		s = s.Append(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Distributed[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for b := range s.buckets {
+			for _, elem := range s.bucket(b) {
+				if !yield(i, elem) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Distributed[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := range s.buckets {
+			for _, elem := range s.bucket(b) {
+				if !yield(elem) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Distributed[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := s.Len() - 1
+		for b := len(s.buckets) - 1; b >= 0; b-- {
+			bucket := s.bucket(b)
+			for k := len(bucket) - 1; k >= 0; k-- {
+				if !yield(i, bucket[k]) {
+					return
+				}
+				i--
+			}
+		}
+	}
+}
+
+// SinglyFromSeq creates a Singly Slice from the elements of a sequence
+func SinglyFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := Singly[T]{}
+	for elem := range seq {
+		node := &singlyNode[T]{elem: elem}
+		// If the list is empty
+		if s.len == 0 {
+			s.start = node
+		} else {
+			s.end.next = node
+		}
+		s.end = node
+		s.len++
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Singly[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		node := s.start
+		for i := 0; i < s.len; i++ {
+			if !yield(i, node.Get()) {
+				return
+			}
+			if node != nil {
+				node = node.next
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Singly[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := s.start
+		for i := 0; i < s.len; i++ {
+			if !yield(node.Get()) {
+				return
+			}
+			if node != nil {
+				node = node.next
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element. As the list can't be walked backwards, the
+// nodes are collected before the first element is yielded
+func (s Singly[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		// Collect the nodes
+		nodes := make([]*singlyNode[T], 0, s.len)
+		node := s.start
+		for i := 0; i < s.len; i++ {
+			nodes = append(nodes, node)
+			if node != nil {
+				node = node.next
+			}
+		}
+
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(i, nodes[i].Get()) {
+				return
+			}
+		}
+	}
+}
+
+// DoublyFromSeq creates a Doubly Slice from the elements of a sequence
+func DoublyFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := Doubly[T]{}
+	for elem := range seq {
+		node := &doublyNode[T]{elem: elem, prev: s.end}
+		// If the list is empty
+		if s.len == 0 {
+			s.start = node
+		} else {
+			s.end.next = node
+		}
+		s.end = node
+		s.len++
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Doubly[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		node := s.start
+		for i := 0; i < s.len; i++ {
+			if !yield(i, node.Get()) {
+				return
+			}
+			if node != nil {
+				node = node.next
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Doubly[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := s.start
+		for i := 0; i < s.len; i++ {
+			if !yield(node.Get()) {
+				return
+			}
+			if node != nil {
+				node = node.next
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Doubly[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		node := s.end
+		for i := s.len - 1; i >= 0; i-- {
+			if !yield(i, node.Get()) {
+				return
+			}
+			if node != nil {
+				node = node.prev
+			}
+		}
+	}
+}
+
+// PersistentFromSeq creates a Persistent Slice from the elements of a sequence
+func PersistentFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := EmptyPersistent[T]().(Persistent[T]).forkTail()
+	for elem := range seq {
+		s = s.push(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Persistent[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		iter := persistentIterator[T]{slice: s, index: -1}
+		for iter.Next() {
+			if !yield(iter.index, iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Persistent[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iter := persistentIterator[T]{slice: s, index: -1}
+		for iter.Next() {
+			if !yield(iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Persistent[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		iter := persistentIterator[T]{slice: s, index: s.Len()}
+		for iter.Prev() {
+			if !yield(iter.index, iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// RopeFromSeq creates a Rope Slice from the elements of a sequence
+func RopeFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	var s []T
+	for elem := range seq {
+		s = append(s, elem)
+	}
+	return RopeFrom(s)
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Rope[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		s.root.each(func(leaf []T) bool {
+			for _, elem := range leaf {
+				if !yield(i, elem) {
+					return false
+				}
+				i++
+			}
+			return true
+		})
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Rope[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.each(func(leaf []T) bool {
+			for _, elem := range leaf {
+				if !yield(elem) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Rope[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		iter := ropeIterator[T]{slice: s, index: s.Len()}
+		for iter.Prev() {
+			if !yield(iter.index, iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// RingFromSeq creates a Ring Slice from the elements of a sequence, with the
+// given capacity. If there are more elements than the capacity, they're
+// handled according to mode
+func RingFromSeq[T any](seq iter.Seq[T], cap int, mode RingMode) Slice[T] {
+	s := EmptyRing[T](cap, mode)
+	for elem := range seq {
+		s = s.Append(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s Ring[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < s.len; i++ {
+			if !yield(i, s.buf[s.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s Ring[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.len; i++ {
+			if !yield(s.buf[s.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s Ring[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := s.len - 1; i >= 0; i-- {
+			if !yield(i, s.buf[s.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// GapBufferFromSeq creates a GapBuffer Slice from the elements of a sequence,
+// with the cursor at the end
+func GapBufferFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := EmptyGapBuffer[T](0)
+	for elem := range seq {
+		s = s.Append(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s GapBuffer[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, elem := range s.buf[:s.gapStart] {
+			if !yield(i, elem) {
+				return
+			}
+		}
+		for i, elem := range s.buf[s.gapEnd:] {
+			if !yield(s.gapStart+i, elem) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s GapBuffer[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range s.buf[:s.gapStart] {
+			if !yield(elem) {
+				return
+			}
+		}
+		for _, elem := range s.buf[s.gapEnd:] {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s GapBuffer[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := s.Len() - 1; i >= 0; i-- {
+			if !yield(i, s.buf[s.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// SkipListFromSeq creates a SkipList Slice from the elements of a sequence
+func SkipListFromSeq[T any](seq iter.Seq[T]) Slice[T] {
+	s := EmptySkipList[T]()
+	for elem := range seq {
+		s = s.Append(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s SkipList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if s.len == 0 {
+			return
+		}
+		node := s.nodes.node(s.offset)
+		for i := 0; i < s.len; i++ {
+			if !yield(i, node.elem) {
+				return
+			}
+			node = node.next[0]
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s SkipList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range s.All() {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s SkipList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if s.len == 0 {
+			return
+		}
+		node := s.nodes.node(s.offset + s.len - 1)
+		for i := s.len - 1; i >= 0; i-- {
+			if !yield(i, node.elem) {
+				return
+			}
+			node = node.prev
+		}
+	}
+}
+
+// RecordSliceFromSeq creates a RecordSlice from the elements of a sequence,
+// encoded with the given codec. The bytes are allocated in memory
+func RecordSliceFromSeq[T any](seq iter.Seq[T], codec Codec[T]) Slice[T] {
+	s := EmptyRecordSlice[T](0, 0, codec)
+	for elem := range seq {
+		s = s.Append(elem)
+	}
+	return s
+}
+
+// All returns an iterator over the indexes and decoded elements of the slice
+func (s RecordSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.codec.Decode(s.record(i))) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the decoded elements of the slice
+func (s RecordSlice[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.Len(); i++ {
+			if !yield(s.codec.Decode(s.record(i))) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and decoded elements of the
+// slice, starting from the last element
+func (s RecordSlice[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := s.Len() - 1; i >= 0; i-- {
+			if !yield(i, s.codec.Decode(s.record(i))) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indexes and elements of a snapshot of the
+// slice
+func (s Synchronized[T]) All() iter.Seq2[int, T] {
+	return Wrap(s.ToGoSlice()).(Wrapper[T]).All()
+}
+
+// Values returns an iterator over the elements of a snapshot of the slice
+func (s Synchronized[T]) Values() iter.Seq[T] {
+	return Wrap(s.ToGoSlice()).(Wrapper[T]).Values()
+}
+
+// Backward returns an iterator over the indexes and elements of a snapshot of
+// the slice, starting from the last element
+func (s Synchronized[T]) Backward() iter.Seq2[int, T] {
+	return Wrap(s.ToGoSlice()).(Wrapper[T]).Backward()
+}
+
+// All returns an iterator over the indexes and elements of the slice
+func (s COW[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		iter := s.state.slice.IterStart()
+		for i := 0; iter.Next(); i++ {
+			if !yield(i, iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the slice
+func (s COW[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iter := s.state.slice.IterStart()
+		for iter.Next() {
+			if !yield(iter.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and elements of the slice,
+// starting from the last element
+func (s COW[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		iter := s.state.slice.IterEnd()
+		for i := s.state.slice.Len() - 1; iter.Prev(); i-- {
+			if !yield(i, iter.Get()) {
+				return
+			}
+		}
+	}
+}
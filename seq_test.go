@@ -0,0 +1,174 @@
+//go:build go1.23
+
+package slice
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type seqSlice interface {
+	Slice[int]
+	All() iter.Seq2[int, int]
+	Values() iter.Seq[int]
+	Backward() iter.Seq2[int, int]
+}
+
+func commonSliceSeqTest(t *testing.T, s Slice[int]) {
+	elems := []int{2, 3, 4}
+	s1 := s.AppendSlice(Wrap(elems))
+	s1 = s1.Slice(s1.Len()-3, s1.Len())
+
+	i := 0
+	for index, elem := range s1.(seqSlice).All() {
+		assert.Equal(t, i, index)
+		assert.Equal(t, elems[i], elem)
+		i++
+	}
+	assert.Equal(t, len(elems), i)
+
+	i = 0
+	for elem := range s1.(seqSlice).Values() {
+		assert.Equal(t, elems[i], elem)
+		i++
+	}
+	assert.Equal(t, len(elems), i)
+
+	i = len(elems) - 1
+	for index, elem := range s1.(seqSlice).Backward() {
+		assert.Equal(t, i, index)
+		assert.Equal(t, elems[i], elem)
+		i--
+	}
+	assert.Equal(t, -1, i)
+
+	// Make sure breaking out of the loop stops the iteration
+	for index := range s1.(seqSlice).All() {
+		assert.Equal(t, 0, index)
+		break
+	}
+	for index := range s1.(seqSlice).Backward() {
+		assert.Equal(t, len(elems)-1, index)
+		break
+	}
+}
+
+// Checks the sequences of a slice that an edit was made from, which can have
+// stale elements, but should still have as many elements as its length
+func commonSliceEditedFromSeqTest(t *testing.T, s Slice[int]) {
+	s.Erase(1)
+	s.EraseRange(0, 1)
+	assert.NotPanics(t, func() {
+		n := 0
+		for range s.(seqSlice).All() {
+			n++
+		}
+		for range s.(seqSlice).Values() {
+			n++
+		}
+		for range s.(seqSlice).Backward() {
+			n++
+		}
+		assert.Equal(t, 3*s.Len(), n)
+	})
+}
+
+func commonSliceFromSeqTest(t *testing.T, from func(iter.Seq[int]) Slice[int]) {
+	s := from(Wrap([]int{}).(Wrapper[int]).Values())
+	commonSliceLenTest(t, s, 0)
+
+	s = from(Wrap([]int{1, 2, 3}).(Wrapper[int]).Values())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+	commonSliceAppendTest(t, s)
+}
+
+func TestWrapper_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptySlice[int](0, 0))
+	commonSliceSeqTest(t, Wrap([]int{1}))
+	commonSliceSeqTest(t, Wrap([]int{1, 2}))
+	commonSliceFromSeqTest(t, WrapSeq[int])
+}
+
+func TestDistributed_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyDistributed[int](0, 2))
+	commonSliceSeqTest(t, DistributedFrom([]int{1}))
+	commonSliceSeqTest(t, DistributedFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, DistributedFromSeq[int])
+}
+
+func TestSingly_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptySingly[int]())
+	commonSliceSeqTest(t, SinglyFrom([]int{1}))
+	commonSliceSeqTest(t, SinglyFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, SinglyFromSeq[int])
+	commonSliceEditedFromSeqTest(t, SinglyFrom([]int{1, 2, 3, 4}))
+}
+
+func TestDoubly_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyDoubly[int]())
+	commonSliceSeqTest(t, DoublyFrom([]int{1}))
+	commonSliceSeqTest(t, DoublyFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, DoublyFromSeq[int])
+	commonSliceEditedFromSeqTest(t, DoublyFrom([]int{1, 2, 3, 4}))
+}
+
+func TestPersistent_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyPersistent[int]())
+	commonSliceSeqTest(t, PersistentFrom([]int{1}))
+	commonSliceSeqTest(t, PersistentFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, PersistentFromSeq[int])
+}
+
+func TestRope_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyRope[int]())
+	commonSliceSeqTest(t, RopeFrom([]int{1}))
+	commonSliceSeqTest(t, RopeFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, RopeFromSeq[int])
+}
+
+func TestRing_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceSeqTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceSeqTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+	commonSliceFromSeqTest(t, func(seq iter.Seq[int]) Slice[int] {
+		return RingFromSeq(seq, 128, RingOverwrite)
+	})
+}
+
+func TestGapBuffer_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyGapBuffer[int](0))
+	commonSliceSeqTest(t, GapBufferFrom([]int{1}))
+	commonSliceSeqTest(t, GapBufferFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, GapBufferFromSeq[int])
+}
+
+func TestSkipList_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptySkipList[int]())
+	commonSliceSeqTest(t, SkipListFrom([]int{1}))
+	commonSliceSeqTest(t, SkipListFrom([]int{1, 2}))
+	commonSliceFromSeqTest(t, SkipListFromSeq[int])
+	commonSliceEditedFromSeqTest(t, SkipListFrom([]int{1, 2, 3, 4}))
+}
+
+func TestRecordSlice_Seq(t *testing.T) {
+	commonSliceSeqTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceSeqTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceSeqTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+	commonSliceFromSeqTest(t, func(seq iter.Seq[int]) Slice[int] {
+		return RecordSliceFromSeq[int](seq, intCodec{})
+	})
+}
+
+func TestSynchronized_Seq(t *testing.T) {
+	commonSliceSeqTest(t, Synchronize(EmptyDistributed[int](0, 2)))
+	commonSliceSeqTest(t, Synchronize(DistributedFrom([]int{1})))
+	commonSliceSeqTest(t, Synchronize(DistributedFrom([]int{1, 2})))
+}
+
+func TestCOW_Seq(t *testing.T) {
+	commonSliceSeqTest(t, CopyOnWrite(EmptyDistributed[int](0, 2)))
+	commonSliceSeqTest(t, CopyOnWrite(DistributedFrom([]int{1})))
+	commonSliceSeqTest(t, CopyOnWrite(DoublyFrom([]int{1, 2})))
+}
@@ -8,18 +8,34 @@ type singlyNode[T any] struct {
 }
 
 func (n *singlyNode[T]) Next() LinkedListNode[T] {
+	if n == nil {
+		return nil
+	}
 	return n.next
 }
 
+// Get gets the node's element. A list that was edited from can have fewer
+// nodes than its length, so a missing (nil) node gets the zero value
 func (n *singlyNode[T]) Get() T {
+	if n == nil {
+		var zero T
+		return zero
+	}
 	return n.elem
 }
 
+// Set sets the node's element, unless the node is missing (nil)
 func (n *singlyNode[T]) Set(elem T) {
-	n.elem = elem
+	if n != nil {
+		n.elem = elem
+	}
 }
 
-// Singly is a Slice type, implemented as a singly linked list
+// Singly is a Slice type, implemented as a singly linked list. Slices of a
+// list share its nodes, so editing a slice can change the other slices of the
+// list. If editing a slice unlinks the nodes another slice was using, the other
+// slice stays safe to read, but the elements it can no longer reach are the
+// zero value
 type Singly[T any] struct {
 	len   int
 	start *singlyNode[T]
@@ -181,6 +197,98 @@ func (s Singly[T]) Slice(i, j int) Slice[T] {
 	return s
 }
 
+// Replaces the nodes between i and j (exclusive) with the given linked list,
+// reusing the nodes of both lists
+func (s Singly[T]) splice(i, j int, elems Singly[T]) Singly[T] {
+	checkRange(i, j, s.len)
+
+	// Erasing the end of the list just slices it, so the erased nodes stay
+	// linked for the slices still using them
+	if elems.len == 0 && j == s.len {
+		return s.Slice(0, i).(Singly[T])
+	}
+
+	// Find the node before the range, if there is one
+	var prev *singlyNode[T]
+	after := s.start
+	if i > 0 {
+		prev = s.node(i - 1)
+		after = prev.next
+	}
+	// Skip over the nodes being removed, to find the node after the range
+	for k := i; k < j; k++ {
+		after = after.next
+	}
+
+	// Calculate the new length
+	newLen := s.len - (j - i) + elems.len
+	// If the list is now empty
+	if newLen == 0 {
+		return Singly[T]{}
+	}
+
+	// If there are elements to insert
+	if elems.len > 0 {
+		// Connect the inserted list to the node after the range
+		elems.end.next = after
+		// Connect the node before the range to the inserted list
+		if prev != nil {
+			prev.next = elems.start
+		} else {
+			s.start = elems.start
+		}
+		// If the range was at the end, the end is the end of the inserted list
+		if j == s.len {
+			s.end = elems.end
+		}
+
+		// Otherwise the range is just unlinked
+	} else {
+		if prev != nil {
+			prev.next = after
+		} else {
+			s.start = after
+		}
+		// If the range was at the end, the end is the node before the range
+		if j == s.len {
+			s.end = prev
+		}
+	}
+
+	// Set the length
+	s.len = newLen
+
+	return s
+}
+
+func (s Singly[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, Singly[T]{})
+}
+
+func (s Singly[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, Singly[T]{})
+}
+
+func (s Singly[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, SinglyFrom(elems).(Singly[T]))
+}
+
+func (s Singly[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	// Try to convert the elements slice to a linked list
+	list, ok := elems.(Singly[T])
+	// If it isn't a linked list
+	if !ok {
+		// Convert it to a linked list
+		list = SinglyFrom(elems.ToGoSlice()).(Singly[T])
+	}
+
+	return s.splice(i, i, list)
+}
+
+func (s Singly[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, SinglyFrom(elems).(Singly[T]))
+}
+
 func (s Singly[T]) Get(i int) T {
 	return s.Node(i).Get()
 }
@@ -190,17 +298,28 @@ func (s Singly[T]) Set(i int, elem T) {
 }
 
 type singlyIterator[T any] struct {
+	list Singly[T]
 	node *singlyNode[T]
-	end  *singlyNode[T]
+	// The node before node. If this is nil when the index is more than 0, it
+	// isn't known and has to be found
+	prev  *singlyNode[T]
+	index int
 }
 
 func (i *singlyIterator[T]) HasNext() bool {
-	return i.node != i.end
+	return i.index+1 < i.list.len
 }
 
 func (i *singlyIterator[T]) Next() bool {
 	if i.HasNext() {
-		i.node = i.node.next
+		i.prev = i.node
+		// If the iterator is before the start, go to the start
+		if i.index == -1 {
+			i.node = i.list.start
+		} else if i.node != nil {
+			i.node = i.node.next
+		}
+		i.index++
 		return true
 	}
 	return false
@@ -229,19 +348,166 @@ func (i *singlyIterator[T]) Set(elem T) {
 	i.Node().Set(elem)
 }
 
-func (s Singly[T]) IterStart() Iterator[T] {
-	if s.Len() == 0 {
-		return &singlyIterator[T]{}
+// Advance moves the iterator n elements. As the list can't be walked
+// backwards, moving backwards walks forwards from the start of the list
+func (i *singlyIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+// Seek moves the iterator to the given index. As the list can't be walked
+// backwards, seeking backwards walks forwards from the start of the list
+func (i *singlyIterator[T]) Seek(index int) {
+	if index < -1 || index > i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+
+	// If the index is before or after the list, there is no node
+	if index == -1 || index == i.list.len {
+		i.node = nil
+		i.prev = nil
+		i.index = index
+		return
+	}
+
+	// If the index is behind the iterator, walk from the start
+	if i.index > index || i.index == -1 || i.index == i.list.len {
+		i.node = i.list.start
+		i.prev = nil
+		i.index = 0
+	}
+	for ; i.index < index && i.node != nil; i.index++ {
+		i.prev = i.node
+		i.node = i.node.next
+	}
+	i.index = index
+}
+
+func (i *singlyIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *singlyIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// Gets the node before the node the iterator is pointed to, finding it if it
+// isn't known
+func (i *singlyIterator[T]) prevNode() *singlyNode[T] {
+	// If the iterator is after the end, the previous node is the end
+	if i.index == i.list.len {
+		return i.list.end
+	}
+	if i.prev == nil && i.index > 0 {
+		i.prev = i.list.node(i.index - 1)
+	}
+	return i.prev
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to. Next, Seek and Advance keep track of the previous node as they
+// walk forwards, so the element is inserted in O(1) time after them. After
+// Remove though, the previous node isn't known, so it has to be found by
+// walking the list from the start, in O(n) time
+func (i *singlyIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+
+	node := &singlyNode[T]{elem: elem}
+	prev := i.prevNode()
+	// Connect the new node to the next node. If the iterator is after the end,
+	// this is the node after the end (if the list is part of a larger list)
+	if i.index < i.list.len {
+		node.next = i.node
+	} else if i.list.end != nil {
+		node.next = i.list.end.next
+	}
+	// Connect the previous node to the new node
+	if prev == nil {
+		i.list.start = node
+	} else {
+		prev.next = node
+	}
+	// If the iterator is after the end, the new node is the end
+	if i.index == i.list.len {
+		i.list.end = node
 	}
-	return &singlyIterator[T]{
-		// Set the node as a new one that is one element out of bounds
-		node: &singlyNode[T]{next: s.start},
-		end:  s.end,
+
+	i.prev = node
+	i.index++
+	i.list.len++
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to. Unlike InsertBefore, the previous node isn't needed, so the
+// element is always inserted in O(1) time
+func (i *singlyIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.list.len {
+		panic("slice: can't insert after the end of the slice")
+	}
+
+	node := &singlyNode[T]{elem: elem}
+	// If the iterator is before the start, insert at the start
+	if i.index == -1 {
+		node.next = i.list.start
+		i.list.start = node
+		if i.list.len == 0 {
+			i.list.end = node
+		}
+	} else {
+		node.next = i.node.next
+		i.node.next = node
+		if i.node == i.list.end {
+			i.list.end = node
+		}
+	}
+
+	i.list.len++
+}
+
+// Remove removes the element the iterator is pointed to, then moves the
+// iterator to the previous element, so that Next moves to the element after the
+// removed one. The element is removed in O(1) time, but if Remove is called
+// again without moving the iterator forwards, the previous node has to be found
+// by walking the list
+func (i *singlyIterator[T]) Remove() {
+	if i.index < 0 || i.index >= i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", i.index))
 	}
+
+	// Unlink the node
+	prev := i.prevNode()
+	if prev == nil {
+		i.list.start = i.node.next
+	} else {
+		prev.next = i.node.next
+	}
+	if i.node == i.list.end {
+		i.list.end = prev
+	}
+	i.list.len--
+	if i.list.len == 0 {
+		i.list = Singly[T]{}
+	}
+
+	// Move back to the previous node, which has an unknown previous node
+	i.node = prev
+	i.prev = nil
+	i.index--
+}
+
+// Slice gets the list the iterator is iterating over, including the changes
+// made by the iterator
+func (i *singlyIterator[T]) Slice() Slice[T] {
+	return i.list
+}
+
+func (s Singly[T]) IterStart() Iterator[T] {
+	return &singlyIterator[T]{list: s, index: -1}
 }
 
 func (s Singly[T]) IterEnd() Iterator[T] {
-	return &singlyIterator[T]{}
+	return &singlyIterator[T]{list: s, index: s.len}
 }
 
 func (s Singly[T]) ReverseIterStart() Iterator[T] {
@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSingly_Append(t *testing.T) {
@@ -30,12 +32,53 @@ func TestSingly_Slice(t *testing.T) {
 	commonSliceSliceTest(t, SinglyFrom([]int{1, 2}))
 }
 
+func TestSingly_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptySingly[int]())
+	commonSliceEraseTest(t, SinglyFrom([]int{1}))
+	commonSliceEraseTest(t, SinglyFrom([]int{1, 2}))
+}
+
+func TestSingly_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptySingly[int]())
+	commonSliceReplaceTest(t, SinglyFrom([]int{1}))
+	commonSliceReplaceTest(t, SinglyFrom([]int{1, 2}))
+}
+
 func TestSingly_Iter(t *testing.T) {
 	commonSliceIterTest(t, EmptySingly[int]())
 	commonSliceIterTest(t, SinglyFrom([]int{1}))
 	commonSliceIterTest(t, SinglyFrom([]int{1, 2}))
 }
 
+func TestSingly_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptySingly[int]())
+	commonSliceRandomAccessIterTest(t, SinglyFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, SinglyFrom([]int{1, 2}))
+}
+
+func TestSingly_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptySingly[int]())
+	commonSliceMutableIterTest(t, SinglyFrom([]int{1}))
+	commonSliceMutableIterTest(t, SinglyFrom([]int{1, 2}))
+}
+
+func TestSingly_EditedFrom(t *testing.T) {
+	// Erasing the end should leave the list that was edited as it was
+	s := SinglyFrom([]int{1, 2, 3})
+	erased := s.Erase(2)
+	assert.Equal(t, []int{1, 2}, erased.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+
+	// Erasing the middle unlinks nodes the list was using, which should be
+	// read as the zero value
+	erased = s.EraseRange(0, 1)
+	assert.Equal(t, []int{3}, erased.ToGoSlice())
+	erased = s.Erase(1)
+	assert.Equal(t, []int{1, 3}, erased.ToGoSlice())
+	assert.Equal(t, []int{1, 3, 0}, s.ToGoSlice())
+	assert.Equal(t, 0, s.Get(2))
+}
+
 // BENCHMARKING
 
 func BenchmarkSingly_Append(b *testing.B) {
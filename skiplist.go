@@ -0,0 +1,453 @@
+package slice
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// The maximum number of levels of a SkipList
+const skipListMaxLevel = 32
+
+// The inverse of the probability that a SkipList node has another level
+const skipListBranching = 4
+
+type skipListNode[T any] struct {
+	elem T
+	// The next node on each of the node's levels
+	next []*skipListNode[T]
+	// The number of elements between the node and the next node on each level,
+	// including the next node
+	width []int
+	// The previous node on the bottom level, which is nil for the first node
+	prev *skipListNode[T]
+}
+
+func (n *skipListNode[T]) Next() LinkedListNode[T] {
+	return n.next[0]
+}
+
+func (n *skipListNode[T]) Prev() LinkedListNode[T] {
+	return n.prev
+}
+
+func (n *skipListNode[T]) Get() T {
+	return n.elem
+}
+
+func (n *skipListNode[T]) Set(elem T) {
+	n.elem = elem
+}
+
+// The nodes of a SkipList, which are shared by the slices of the list
+type skipListNodes[T any] struct {
+	// The head of the list, which has every level but no element
+	head *skipListNode[T]
+	// The number of levels being used
+	level int
+	len   int
+}
+
+func newSkipListNodes[T any]() *skipListNodes[T] {
+	return &skipListNodes[T]{
+		head: &skipListNode[T]{
+			next:  make([]*skipListNode[T], skipListMaxLevel),
+			width: make([]int, skipListMaxLevel),
+		},
+		level: 1,
+	}
+}
+
+// Gets a random number of levels for a new node
+func skipListRandomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.Intn(skipListBranching) == 0 {
+		level++
+	}
+	return level
+}
+
+// Finds the last node before the given index on each level, and the index of
+// each node. The head is at index -1
+func (l *skipListNodes[T]) path(i int) (nodes [skipListMaxLevel]*skipListNode[T], indexes [skipListMaxLevel]int) {
+	node, index := l.head, -1
+	for level := l.level - 1; level >= 0; level-- {
+		for node.next[level] != nil && index+node.width[level] < i {
+			index += node.width[level]
+			node = node.next[level]
+		}
+		nodes[level], indexes[level] = node, index
+	}
+	return nodes, indexes
+}
+
+// Gets the node at the given index
+func (l *skipListNodes[T]) node(i int) *skipListNode[T] {
+	nodes, _ := l.path(i)
+	return nodes[0].next[0]
+}
+
+// Inserts the given element at the given index, returning the new node
+func (l *skipListNodes[T]) insert(i int, elem T) *skipListNode[T] {
+	nodes, indexes := l.path(i)
+
+	level := skipListRandomLevel()
+	// If the node is taller than the list, the head is before it on the new
+	// levels
+	for ; l.level < level; l.level++ {
+		nodes[l.level], indexes[l.level] = l.head, -1
+	}
+
+	node := &skipListNode[T]{
+		elem:  elem,
+		next:  make([]*skipListNode[T], level),
+		width: make([]int, level),
+	}
+	if nodes[0] != l.head {
+		node.prev = nodes[0]
+	}
+	for k := 0; k < l.level; k++ {
+		before := nodes[k]
+		// If the node isn't on this level, it's skipped over
+		if k >= level {
+			if before.next[k] != nil {
+				before.width[k]++
+			}
+			continue
+		}
+
+		// Otherwise link the node in, and split the width between the nodes
+		node.next[k] = before.next[k]
+		if node.next[k] != nil {
+			node.width[k] = before.width[k] - (i - indexes[k]) + 1
+		}
+		before.next[k] = node
+		before.width[k] = i - indexes[k]
+	}
+	if node.next[0] != nil {
+		node.next[0].prev = node
+	}
+
+	l.len++
+	return node
+}
+
+// Sets the elements of the nodes from the given index
+func (l *skipListNodes[T]) set(i int, elems []T) {
+	node := l.node(i)
+	for _, elem := range elems {
+		node.elem = elem
+		node = node.next[0]
+	}
+}
+
+// Removes the node at the given index
+func (l *skipListNodes[T]) remove(i int) {
+	nodes, _ := l.path(i)
+	node := nodes[0].next[0]
+
+	for k := 0; k < l.level; k++ {
+		before := nodes[k]
+		if before.next[k] == node {
+			before.next[k] = node.next[k]
+			before.width[k] += node.width[k] - 1
+		} else if before.next[k] != nil {
+			before.width[k]--
+		}
+	}
+	if node.next[0] != nil {
+		node.next[0].prev = node.prev
+	}
+
+	// Remove any levels that are no longer used
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+	l.len--
+}
+
+// SkipList is a Slice type, implemented as an indexable skip list (see
+// https://en.wikipedia.org/wiki/Skip_list#Indexable_skiplist). The links
+// between the nodes store how many elements they skip over, so Get, Set, Slice
+// and inserting or erasing an element are all O(log n) expected time. Slices
+// of a SkipList share its nodes, and like a Go slice, the nodes after the end
+// of a slice are its capacity, which Append overwrites. Erasing moves the
+// erased nodes into the capacity rather than removing them, so the list never
+// shrinks, and the other slices of the list stay readable, although their
+// elements can change
+type SkipList[T any] struct {
+	nodes *skipListNodes[T]
+	// The index of the slice's first element in the list
+	offset int
+	len    int
+}
+
+// EmptySkipList creates an empty SkipList Slice
+func EmptySkipList[T any]() Slice[T] {
+	return SkipList[T]{nodes: newSkipListNodes[T]()}
+}
+
+// SkipListFrom creates a SkipList Slice from a Go slice
+func SkipListFrom[T any](elems []T) Slice[T] {
+	return EmptySkipList[T]().Append(elems...)
+}
+
+// Replaces the elements between i and j (exclusive) with the given elements
+func (s SkipList[T]) splice(i, j int, elems []T) Slice[T] {
+	checkRange(i, j, s.len)
+	// If the list is the zero value, create the nodes
+	if s.nodes == nil {
+		s.nodes = newSkipListNodes[T]()
+	}
+	length := s.len + len(elems) - (j - i)
+
+	// Set the elements being replaced first
+	n := atMost(j-i, len(elems))
+	s.nodes.set(s.offset+i, elems[:n])
+	i, elems = i+n, elems[n:]
+
+	if i < j {
+		// Move the nodes left over to the end of the slice, so the slices that
+		// include them still have as many nodes
+		for k := i; k < j; k++ {
+			elem := s.nodes.node(s.offset + i).elem
+			s.nodes.remove(s.offset + i)
+			s.nodes.insert(s.offset+s.len-1, elem)
+		}
+	} else if j == s.len {
+		// Like append, set the elements in the capacity before adding nodes
+		n = atMost(len(elems), s.Cap()-s.len)
+		s.nodes.set(s.offset+s.len, elems[:n])
+		for k, elem := range elems[n:] {
+			s.nodes.insert(s.offset+s.len+n+k, elem)
+		}
+	} else {
+		for k, elem := range elems {
+			s.nodes.insert(s.offset+i+k, elem)
+		}
+	}
+	s.len = length
+	return s
+}
+
+func (s SkipList[T]) Append(elems ...T) Slice[T] {
+	return s.splice(s.len, s.len, elems)
+}
+
+func (s SkipList[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	return s.Append(elems.ToGoSlice()...)
+}
+
+func (s SkipList[T]) Prepend(elems ...T) Slice[T] {
+	return s.splice(0, 0, elems)
+}
+
+func (s SkipList[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	return s.Prepend(elems.ToGoSlice()...)
+}
+
+func (s SkipList[T]) Node(i int) LinkedListNode[T] {
+	if i < 0 || i >= s.len {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	return s.nodes.node(s.offset + i)
+}
+
+func (s SkipList[T]) Slice(i, j int) Slice[T] {
+	if j < i {
+		panic(fmt.Sprintf("invalid slice index: %d > %d", i, j))
+	}
+
+	// If the slice needs to be grown past its capacity
+	if j > s.Cap() {
+		s = s.Slice(0, s.Cap()).Append(make([]T, j-s.Cap())...).(SkipList[T])
+	}
+
+	s.offset += i
+	s.len = j - i
+	return s
+}
+
+func (s SkipList[T]) Erase(i int) Slice[T] {
+	return s.splice(i, i+1, nil)
+}
+
+func (s SkipList[T]) EraseRange(i, j int) Slice[T] {
+	return s.splice(i, j+1, nil)
+}
+
+func (s SkipList[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.splice(i, i, elems)
+}
+
+func (s SkipList[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.splice(i, i, elems.ToGoSlice())
+}
+
+func (s SkipList[T]) Replace(i, j int, elems ...T) Slice[T] {
+	return s.splice(i, j, elems)
+}
+
+func (s SkipList[T]) Get(i int) T {
+	return s.Node(i).Get()
+}
+
+func (s SkipList[T]) Set(i int, elem T) {
+	s.Node(i).Set(elem)
+}
+
+type skipListIterator[T any] struct {
+	list  SkipList[T]
+	node  *skipListNode[T]
+	index int
+}
+
+func (i *skipListIterator[T]) HasNext() bool {
+	return i.index+1 < i.list.len
+}
+
+func (i *skipListIterator[T]) Next() bool {
+	if i.HasNext() {
+		// If the iterator is before the start, find the start
+		if i.node == nil {
+			i.Seek(i.index + 1)
+		} else {
+			i.node = i.node.next[0]
+			i.index++
+		}
+		return true
+	}
+	return false
+}
+
+func (i *skipListIterator[T]) HasPrev() bool {
+	return i.index > 0
+}
+
+func (i *skipListIterator[T]) Prev() bool {
+	if i.HasPrev() {
+		// If the iterator is after the end, find the end
+		if i.node == nil {
+			i.Seek(i.index - 1)
+		} else {
+			i.node = i.node.prev
+			i.index--
+		}
+		return true
+	}
+	return false
+}
+
+func (i *skipListIterator[T]) Node() LinkedListNode[T] {
+	return i.node
+}
+
+func (i *skipListIterator[T]) Get() T {
+	return i.node.elem
+}
+
+func (i *skipListIterator[T]) Set(elem T) {
+	i.node.elem = elem
+}
+
+func (i *skipListIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+// Seek moves the iterator to the given index, in O(log n) expected time
+func (i *skipListIterator[T]) Seek(index int) {
+	if index < -1 || index > i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+
+	i.index = index
+	if index == -1 || index == i.list.len {
+		i.node = nil
+	} else {
+		i.node = i.list.nodes.node(i.list.offset + index)
+	}
+}
+
+func (i *skipListIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *skipListIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+// InsertBefore inserts the given element before the element the iterator is
+// pointed to, in O(log n) expected time
+func (i *skipListIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.list = i.list.Insert(i.index, elem).(SkipList[T])
+	i.index++
+}
+
+// InsertAfter inserts the given element after the element the iterator is
+// pointed to, in O(log n) expected time
+func (i *skipListIterator[T]) InsertAfter(elem T) {
+	if i.index >= i.list.len {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.list = i.list.Insert(i.index+1, elem).(SkipList[T])
+}
+
+// Remove removes the element the iterator is pointed to in O(log n) expected
+// time, then moves the iterator to the previous element
+func (i *skipListIterator[T]) Remove() {
+	if i.index < 0 || i.index >= i.list.len {
+		panic(fmt.Sprintf("index [%d] out of range", i.index))
+	}
+
+	prev := i.node.prev
+	i.list = i.list.Erase(i.index).(SkipList[T])
+	i.index--
+	if i.index >= 0 {
+		i.node = prev
+	} else {
+		i.node = nil
+	}
+}
+
+// Slice gets the list the iterator is iterating over, including the changes
+// made by the iterator
+func (i *skipListIterator[T]) Slice() Slice[T] {
+	return i.list
+}
+
+func (s SkipList[T]) IterStart() Iterator[T] {
+	return &skipListIterator[T]{list: s, index: -1}
+}
+
+func (s SkipList[T]) IterEnd() Iterator[T] {
+	return &skipListIterator[T]{list: s, index: s.len}
+}
+
+func (s SkipList[T]) ReverseIterStart() Iterator[T] {
+	return Reverse(s.IterEnd())
+}
+
+func (s SkipList[T]) ReverseIterEnd() Iterator[T] {
+	return Reverse(s.IterStart())
+}
+
+func (s SkipList[T]) DeepCopy() Slice[T] {
+	return SkipListFrom(s.ToGoSlice())
+}
+
+func (s SkipList[T]) Len() int {
+	return s.len
+}
+
+func (s SkipList[T]) Cap() int {
+	if s.nodes == nil {
+		return 0
+	}
+	return s.nodes.len - s.offset
+}
+
+func (s SkipList[T]) ToGoSlice() []T {
+	return ToGoSlice[T](s)
+}
@@ -0,0 +1,182 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipList_Append(t *testing.T) {
+	commonSliceAppendTest(t, EmptySkipList[int]())
+	commonSliceAppendTest(t, SkipListFrom([]int{1}))
+	commonSliceAppendTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Prepend(t *testing.T) {
+	commonSlicePrependTest(t, EmptySkipList[int]())
+	commonSlicePrependTest(t, SkipListFrom([]int{1}))
+	commonSlicePrependTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Insert(t *testing.T) {
+	commonSliceInsertTest(t, EmptySkipList[int]())
+	commonSliceInsertTest(t, SkipListFrom([]int{1}))
+	commonSliceInsertTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Slice(t *testing.T) {
+	commonSliceSliceTest(t, EmptySkipList[int]())
+	commonSliceSliceTest(t, SkipListFrom([]int{1}))
+	commonSliceSliceTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptySkipList[int]())
+	commonSliceEraseTest(t, SkipListFrom([]int{1}))
+	commonSliceEraseTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptySkipList[int]())
+	commonSliceReplaceTest(t, SkipListFrom([]int{1}))
+	commonSliceReplaceTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_Iter(t *testing.T) {
+	commonSliceIterTest(t, EmptySkipList[int]())
+	commonSliceIterTest(t, SkipListFrom([]int{1}))
+	commonSliceIterTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, EmptySkipList[int]())
+	commonSliceReverseIterTest(t, SkipListFrom([]int{1}))
+	commonSliceReverseIterTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptySkipList[int]())
+	commonSliceRandomAccessIterTest(t, SkipListFrom([]int{1}))
+	commonSliceRandomAccessIterTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestSkipList_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptySkipList[int]())
+	commonSliceMutableIterTest(t, SkipListFrom([]int{1}))
+	commonSliceMutableIterTest(t, SkipListFrom([]int{1, 2}))
+}
+
+// Checks that the width of every link is the number of nodes it skips over
+func checkSkipListNodes(t *testing.T, l *skipListNodes[int]) {
+	// Find the index of every node
+	indexes := make(map[*skipListNode[int]]int)
+	index := 0
+	var prev *skipListNode[int]
+	for node := l.head.next[0]; node != nil; node = node.next[0] {
+		assert.Equal(t, prev, node.prev)
+		indexes[node] = index
+		index++
+		prev = node
+	}
+	assert.Equal(t, l.len, index)
+
+	indexes[l.head] = -1
+	for node := range indexes {
+		for level, next := range node.next {
+			if next != nil {
+				assert.Equal(t, indexes[next]-indexes[node], node.width[level])
+			}
+		}
+	}
+}
+
+func TestSkipList_Edits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expected := make([]int, 0)
+	s := EmptySkipList[int]()
+	for k := 0; k < 1000; k++ {
+		i := r.Intn(len(expected) + 1)
+		j := i + r.Intn(atMost(10, len(expected)-i)+1)
+		elems := make([]int, r.Intn(10))
+		for e := range elems {
+			elems[e] = k
+		}
+		s = s.Replace(i, j, elems...)
+		expected = append(expected[:i], append(elems, expected[j:]...)...)
+		assert.Equal(t, expected, s.ToGoSlice())
+	}
+	checkSkipListNodes(t, s.(SkipList[int]).nodes)
+
+	// Check the elements can be got and set
+	for i := range expected {
+		commonSliceGetTest(t, s, i, expected[i])
+		s.Set(i, -i)
+	}
+	for i := range expected {
+		assert.Equal(t, -i, s.(LinkedList[int]).Node(i).Get())
+	}
+
+	// Check slicing then editing the slice changes the list
+	sliced := s.Slice(10, 20)
+	assert.Equal(t, []int{-10, -11, -12}, sliced.Slice(0, 3).ToGoSlice())
+	sliced = sliced.Erase(0)
+	commonSliceGetTest(t, sliced, 0, -11)
+	commonSliceGetTest(t, s, 10, -11)
+	checkSkipListNodes(t, s.(SkipList[int]).nodes)
+}
+
+func TestSkipList_EditedFrom(t *testing.T) {
+	// The list that was edited should still be readable, with the erased
+	// elements moved to the end like append does with a Go slice
+	s := SkipListFrom([]int{1, 2, 3})
+	erased := s.Erase(0)
+	assert.Equal(t, []int{2, 3}, erased.ToGoSlice())
+	assert.Equal(t, []int{2, 3, 1}, s.ToGoSlice())
+	assert.Equal(t, 3, erased.Cap())
+
+	// Appending should overwrite the capacity rather than grow the list
+	erased.Append(4)
+	assert.Equal(t, []int{2, 3, 4}, s.ToGoSlice())
+	checkSkipListNodes(t, s.(SkipList[int]).nodes)
+
+	s = SkipListFrom([]int{1, 2, 3, 4, 5})
+	erased = s.EraseRange(1, 2)
+	assert.Equal(t, []int{1, 4, 5}, erased.ToGoSlice())
+	assert.Equal(t, 5, s.Len())
+	assert.Len(t, s.ToGoSlice(), 5)
+	erased.Erase(2)
+	sliced := s.Slice(1, 3)
+	sliced.Insert(1, 6, 7)
+	assert.Len(t, s.ToGoSlice(), 5)
+	assert.Equal(t, []int{1, 4}, erased.Slice(0, 2).ToGoSlice())
+	checkSkipListNodes(t, s.(SkipList[int]).nodes)
+}
+
+// BENCHMARKING
+
+func BenchmarkSkipList_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, EmptySkipList[int]())
+}
+
+func BenchmarkSkipList_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, EmptySkipList[int]())
+}
+
+func BenchmarkSkipList_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, EmptySkipList[int]())
+}
+
+func BenchmarkSkipList_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, EmptySkipList[int]())
+}
+
+func BenchmarkSkipList_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, EmptySkipList[int]())
+}
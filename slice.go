@@ -1,5 +1,7 @@
 package slice
 
+import "fmt"
+
 // Iterator is an interface type for an iterator
 type Iterator[T any] interface {
 	// HasNext returns whether there is a next element
@@ -23,6 +25,57 @@ type Iterator[T any] interface {
 	Set(T)
 }
 
+// RandomAccessIterator is an interface type for an iterator that can be moved to
+// any element. The indexes of the elements are the same as the slice's, with the
+// start of the slice (before the first element) at -1, and the end of the slice
+// (after the last element) at the slice's length
+type RandomAccessIterator[T any] interface {
+	Iterator[T]
+
+	// Advance moves the iterator forwards the given number of elements, or
+	// backwards if the number is negative. Panics if the iterator would be moved
+	// out of range
+	Advance(int)
+
+	// Seek moves the iterator to the given index. Panics if the index is out of
+	// range
+	Seek(int)
+
+	// Index gets the index of the element the iterator is currently pointed to
+	Index() int
+
+	// Distance gets the number of elements between the iterator and the given
+	// iterator, which is negative if the given iterator is before this one
+	Distance(RandomAccessIterator[T]) int
+}
+
+// MutableIterator is an interface type for an iterator that can insert and
+// remove elements. As the Slice types are values, the changes are made to the
+// iterator's copy of the slice, which is returned by Slice
+type MutableIterator[T any] interface {
+	Iterator[T]
+
+	// InsertBefore inserts the given element before the element the iterator is
+	// currently pointed to. The iterator stays pointed to the same element.
+	// Panics if the iterator is before the start of the slice
+	InsertBefore(T)
+
+	// InsertAfter inserts the given element after the element the iterator is
+	// currently pointed to. The iterator stays pointed to the same element, so
+	// Next moves to the new element. Panics if the iterator is after the end of
+	// the slice
+	InsertAfter(T)
+
+	// Remove removes the element the iterator is currently pointed to, then
+	// moves the iterator to the previous element (or the start of the slice), so
+	// that Next moves to the element after the removed one
+	Remove()
+
+	// Slice gets the slice the iterator is iterating over, including the
+	// changes made by the iterator
+	Slice() Slice[T]
+}
+
 // Slice is an interface type for a generic data structure that behaves like a []T
 // type
 type Slice[T any] interface {
@@ -44,6 +97,34 @@ type Slice[T any] interface {
 	// `slice[i:j]`
 	Slice(int, int) Slice[T]
 
+	// Erase removes the element at the given index. This function is roughly
+	// equivalent to `append(slice[:i], slice[i+1:]...)`. Like append, the
+	// returned slice can share elements with this slice, so the elements of
+	// this slice can be (and probably will be) changed, but it stays safe to read
+	Erase(int) Slice[T]
+
+	// EraseRange removes the elements between the given indexes (inclusive).
+	// This function is roughly equivalent to `append(slice[:i], slice[j+1:]...)`.
+	// Like Erase, the elements of this slice can be changed, but it stays safe
+	// to read
+	EraseRange(int, int) Slice[T]
+
+	// Insert inserts the given element(s) at the given index. This function is
+	// roughly equivalent to `append(slice[:i], append(elems, slice[i:]...)...)`.
+	// Like Erase, the elements of this slice can be changed, but it stays safe
+	// to read
+	Insert(int, ...T) Slice[T]
+
+	// InsertSlice inserts the elements in the given slice at the given index,
+	// in the same way as Insert
+	InsertSlice(int, Slice[T]) Slice[T]
+
+	// Replace replaces the elements between the given indexes (exclusive) with
+	// the given element(s). This function is roughly equivalent to
+	// `append(slice[:i], append(elems, slice[j:]...)...)`. Like Erase, the
+	// elements of this slice can be changed, but it stays safe to read
+	Replace(int, int, ...T) Slice[T]
+
 	// Get gets the element at the given index. This function is roughly equivalent
 	// to `slice[i]`
 	Get(int) T
@@ -52,7 +133,16 @@ type Slice[T any] interface {
 	// roughly equivalent to `slice[i] = elem`
 	Set(int, T)
 
-	// IterStart creates an iterator, pointed to the first element
+	// IterStart creates an iterator, pointed to the start of the slice. The
+	// iterators of most of the Slice types in this package are
+	// RandomAccessIterators and MutableIterators (although the linked list
+	// types walk the list to move their iterators). The exceptions are
+	// Persistent and COW, whose iterators are only RandomAccessIterators, and
+	// Synchronized, which iterates over a copy of the wrapped slice, so the
+	// changes made by its iterators (including Set) aren't seen by the
+	// Synchronized.
+	// ConcurrentDistributed isn't a Slice, but its iterators are also only
+	// RandomAccessIterators, and their Set panics
 	IterStart() Iterator[T]
 
 	// ReverseIterStart creates a reverse iterator, pointed to the first element
@@ -110,19 +200,17 @@ func ToGoSlice[T any](s Slice[T]) []T {
 
 // Erase returns a slice where the element at the given index is erased. Equivalent to
 // `append(s[:index], s[index + 1:]...)`. Warning: this function does not copy
-// s, so the contents of s can be (and probably will be) modified. The
-// Slice.Erase() function should be used instead of this function where
-// possible, as it can be faster
+// s, so the contents of s can be (and probably will be) modified. This function
+// is equivalent to s.Erase(index)
 func Erase[T any](s Slice[T], index int) Slice[T] {
-	return s.Slice(0, index).DeepCopy().AppendSlice(s.Slice(index+1, s.Len()))
+	return s.Erase(index)
 }
 
 // EraseRange returns a slice where the range of elements are erased. Equivalent to
 // `append(s[:i], s[j + 1:]...)`. Warning: this function does not copy s, so the
-// contents of s can be modified. The Slice.EraseRange function should be used
-// instead of this function where possible, as it can be faster
+// contents of s can be modified. This function is equivalent to s.EraseRange(i, j)
 func EraseRange[T any](s Slice[T], i, j int) Slice[T] {
-	return s.Slice(0, i).DeepCopy().AppendSlice(s.Slice(j+1, s.Len()))
+	return s.EraseRange(i, j)
 }
 
 // Insert returns a slice where the given element was inserted at the given index.
@@ -131,12 +219,9 @@ func EraseRange[T any](s Slice[T], i, j int) Slice[T] {
 //  s = append(s[:index + 1], s[index:])
 //  s[index] = elem
 //
-// The Slice.Insert() function should be used instead of this function where
-// possible, as it can be faster
+// This function is equivalent to s.Insert(index, elem)
 func Insert[T any](s Slice[T], index int, elem T) Slice[T] {
-	s = s.Slice(0, index+1).DeepCopy().AppendSlice(s.Slice(index, s.Len()))
-	s.Set(index, elem)
-	return s
+	return s.Insert(index, elem)
 }
 
 // InsertSlice returns a slice where the given slice of elements were inserted at the given
@@ -145,17 +230,16 @@ func Insert[T any](s Slice[T], index int, elem T) Slice[T] {
 //  s = append(s[:index + len(elems)], s[index:])
 //  copy(s[index:], elems)
 //
-// The Slice.InsertSlice() function should be used instead of this function where
-// possible, as it can be faster
+// This function is equivalent to s.InsertSlice(index, elems)
 func InsertSlice[T any](s Slice[T], index int, elems Slice[T]) Slice[T] {
-	s = s.Slice(0, index+elems.Len()).DeepCopy().AppendSlice(s.Slice(index, s.Len()))
-	iter := elems.IterStart()
-	i := 0
-	for iter.Next() {
-		s.Set(index+i, iter.Get())
-		i++
+	return s.InsertSlice(index, elems)
+}
+
+// Panics if the given range isn't inside a slice of the given length
+func checkRange(i, j, len int) {
+	if i < 0 || j < i || j > len {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d] with length %d", i, j, len))
 	}
-	return s
 }
 
 // ReverseIterator is a reverse iterator, essentially an inverted iterator
@@ -190,3 +274,27 @@ func (i ReverseIterator[T]) HasPrev() bool {
 func (i ReverseIterator[T]) Prev() bool {
 	return i.Iterator.Next()
 }
+
+// Creates an iterator pointed to the element at the given index. If the index
+// is the length of the slice, the iterator is pointed to the end of the slice
+func iterAt[T any](s Slice[T], i int) Iterator[T] {
+	if i < 0 || i > s.Len() {
+		panic(fmt.Sprintf("index [%d] out of range", i))
+	}
+	if i == s.Len() {
+		return s.IterEnd()
+	}
+
+	iter := s.IterStart()
+	// If the iterator can be moved to the index
+	if iter, ok := iter.(RandomAccessIterator[T]); ok {
+		iter.Seek(i)
+		return iter
+	}
+
+	// Otherwise walk the iterator to the index
+	for k := 0; k <= i; k++ {
+		iter.Next()
+	}
+	return iter
+}
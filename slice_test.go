@@ -6,6 +6,60 @@ import (
 	"testing"
 )
 
+// Creates one of the Slice types, for the tests that run against every type
+type sliceFactory struct {
+	name string
+	// Creates a slice containing the given elements, which aren't kept
+	from func(elems []int) Slice[int]
+	// Whether the slice is a shared handle, whose methods change every copy
+	// of it instead of returning a new slice
+	handle bool
+	// Whether the iterators can move backwards
+	prev bool
+}
+
+// The factories for every Slice type. Small bucket capacities are used, so the
+// elements cross bucket boundaries often
+var sliceFactories = []sliceFactory{
+	{"Wrapper", func(elems []int) Slice[int] {
+		return Wrap(append([]int{}, elems...))
+	}, false, true},
+	{"Distributed1", func(elems []int) Slice[int] {
+		return EmptyDistributed[int](0, 1).Append(elems...)
+	}, false, true},
+	{"Distributed2", func(elems []int) Slice[int] {
+		return EmptyDistributed[int](0, 2).Append(elems...)
+	}, false, true},
+	{"Distributed3", func(elems []int) Slice[int] {
+		return EmptyDistributed[int](0, 3).Append(elems...)
+	}, false, true},
+	{"Singly", SinglyFrom[int], false, false},
+	{"Doubly", DoublyFrom[int], false, true},
+	{"Persistent", PersistentFrom[int], false, true},
+	{"Rope", RopeFrom[int], false, true},
+	// The capacity is big enough that no elements are overwritten
+	{"Ring", func(elems []int) Slice[int] {
+		return RingFrom(elems, 1024, RingOverwrite)
+	}, false, true},
+	{"GapBuffer", GapBufferFrom[int], false, true},
+	{"SkipList", SkipListFrom[int], false, true},
+	{"RecordSlice", func(elems []int) Slice[int] {
+		return RecordSliceFrom[int](intRecords(elems...), intCodec{}, nil)
+	}, false, true},
+	{"Synchronized", func(elems []int) Slice[int] {
+		return Synchronize(Wrap(append([]int{}, elems...)))
+	}, true, true},
+	{"COW", func(elems []int) Slice[int] {
+		return CopyOnWrite(EmptyDistributed[int](0, 2).Append(elems...))
+	}, true, true},
+	// A ConcurrentDistributed isn't a Slice, so its snapshot is used
+	{"ConcurrentDistributed", func(elems []int) Slice[int] {
+		s := EmptyConcurrentDistributed[int](2)
+		s.Append(elems...)
+		return s.Snapshot()
+	}, false, true},
+}
+
 func commonSliceGetTest(t *testing.T, s Slice[int], index int, expected int) {
 	assert.Equal(t, expected, s.Get(index))
 }
@@ -90,7 +144,67 @@ func commonSliceSliceTest(t *testing.T, s Slice[int]) {
 }
 
 func commonSliceEraseTest(t *testing.T, s Slice[int]) {
-	// todo
+	s1 := s.Append(1, 2, 3, 4, 5)
+	s1 = s1.Erase(s.Len() + 1)
+	commonSliceLenTest(t, s1, s.Len()+4)
+	assert.Equal(t, []int{1, 3, 4, 5}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.EraseRange(s.Len()+1, s.Len()+2)
+	commonSliceLenTest(t, s1, s.Len()+2)
+	assert.Equal(t, []int{1, 5}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Erase(s1.Len() - 1)
+	commonSliceLenTest(t, s1, s.Len()+1)
+	commonSliceGetTest(t, s1, s1.Len()-1, 1)
+
+	s1 = s.Prepend(1, 2, 3)
+	s1 = s1.Erase(0)
+	commonSliceLenTest(t, s1, s.Len()+2)
+	commonSliceGetTest(t, s1, 0, 2)
+	commonSliceGetTest(t, s1, 1, 3)
+
+	s1 = s1.EraseRange(0, s1.Len()-1)
+	commonSliceLenTest(t, s1, 0)
+}
+
+func commonSliceReplaceTest(t *testing.T, s Slice[int]) {
+	s1 := s.Append(1, 2, 3)
+	s1 = s1.Replace(s.Len(), s.Len()+2, 4)
+	assert.Equal(t, []int{4, 3}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Replace(s.Len()+1, s.Len()+2, 5, 6, 7)
+	assert.Equal(t, []int{4, 5, 6, 7}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Insert(0, 8, 9)
+	commonSliceLenTest(t, s1, s.Len()+6)
+	commonSliceGetTest(t, s1, 0, 8)
+	commonSliceGetTest(t, s1, 1, 9)
+
+	s1 = s1.Replace(s1.Len()-1, s1.Len())
+	assert.Equal(t, []int{4, 5, 6}, s1.Slice(s1.Len()-3, s1.Len()).ToGoSlice())
+
+	// Replace ranges all over a longer slice, checking it against a Go slice
+	expected := make([]int, 20)
+	for i := range expected {
+		expected[i] = i
+	}
+	s1 = s.Slice(0, 0).Append(expected...)
+	for _, r := range []struct {
+		i, j  int
+		elems []int
+	}{
+		{0, 0, []int{-1, -2, -3}},
+		{5, 9, nil},
+		{2, 3, []int{-4, -5, -6, -7}},
+		{10, 12, []int{-8}},
+		{15, 19, []int{-9, -10}},
+		{3, 17, nil},
+		{1, 1, []int{-11, -12, -13, -14, -15}},
+	} {
+		s1 = s1.Replace(r.i, r.j, r.elems...)
+		expected = append(expected[:r.i:r.i], append(append([]int{}, r.elems...), expected[r.j:]...)...)
+		assert.Equal(t, expected, s1.ToGoSlice())
+	}
 }
 
 func commonSliceIterTest(t *testing.T, s Slice[int]) {
@@ -117,6 +231,105 @@ func commonSliceReverseIterTest(t *testing.T, s Slice[int]) {
 	assert.Equal(t, -1, i)
 }
 
+func commonSliceRandomAccessIterTest(t *testing.T, s Slice[int]) {
+	elems := []int{2, 3, 4, 5, 6}
+	s1 := s.AppendSlice(Wrap(elems))
+	s1 = s1.Slice(s1.Len()-len(elems), s1.Len())
+
+	iter := s1.IterStart().(RandomAccessIterator[int])
+	assert.Equal(t, -1, iter.Index())
+
+	iter.Seek(2)
+	assert.Equal(t, 2, iter.Index())
+	assert.Equal(t, 4, iter.Get())
+
+	iter.Advance(2)
+	assert.Equal(t, 6, iter.Get())
+	assert.False(t, iter.HasNext())
+
+	iter.Advance(-3)
+	assert.Equal(t, 1, iter.Index())
+	assert.Equal(t, 3, iter.Get())
+	assert.True(t, iter.Next())
+	assert.Equal(t, 4, iter.Get())
+
+	end := s1.IterEnd().(RandomAccessIterator[int])
+	assert.Equal(t, len(elems), end.Index())
+	assert.Equal(t, 3, iter.Distance(end))
+	assert.Equal(t, -3, end.Distance(iter))
+
+	end.Seek(-1)
+	assert.True(t, end.Next())
+	assert.Equal(t, 2, end.Get())
+
+	iter.Seek(len(elems))
+	assert.False(t, iter.HasNext())
+	assert.Panics(t, func() {
+		iter.Advance(1)
+	})
+	assert.Panics(t, func() {
+		iter.Seek(-2)
+	})
+
+	empty := s.Slice(0, 0).IterEnd().(RandomAccessIterator[int])
+	assert.Equal(t, 0, empty.Index())
+	empty.Seek(-1)
+	assert.False(t, empty.HasNext())
+}
+
+func commonSliceMutableIterTest(t *testing.T, s Slice[int]) {
+	base := s.DeepCopy().ToGoSlice()
+	s1 := s.Append(10, 20, 30, 40, 50, 60)
+	iter := s1.IterStart().(MutableIterator[int])
+	for iter.Next() {
+		switch iter.Get() {
+		case 20, 60:
+			iter.Remove()
+		case 30:
+			iter.InsertAfter(35)
+		case 50:
+			iter.InsertBefore(45)
+			assert.Equal(t, 50, iter.Get())
+		}
+	}
+	s1 = iter.Slice()
+	commonSliceLenTest(t, s1, s.Len()+6)
+	assert.Equal(t, append(base, 10, 30, 35, 40, 45, 50), s1.ToGoSlice())
+
+	// Remove elements consecutively
+	iter = s1.IterStart().(MutableIterator[int])
+	for i := 0; i <= s.Len()+2; i++ {
+		iter.Next()
+	}
+	assert.Equal(t, 35, iter.Get())
+	iter.Remove()
+	assert.Equal(t, 30, iter.Get())
+	iter.Remove()
+	assert.True(t, iter.Next())
+	assert.Equal(t, 40, iter.Get())
+	s1 = iter.Slice()
+	assert.Equal(t, append(base, 10, 40, 45, 50), s1.ToGoSlice())
+
+	// Remove every element
+	iter = s1.Slice(s.Len(), s1.Len()).IterStart().(MutableIterator[int])
+	for iter.Next() {
+		iter.Remove()
+	}
+	commonSliceLenTest(t, iter.Slice(), 0)
+
+	// Insert into an empty slice
+	iter = s.Slice(0, 0).IterEnd().(MutableIterator[int])
+	iter.InsertBefore(2)
+	iter.InsertBefore(3)
+	assert.False(t, iter.HasNext())
+	iter = iter.Slice().IterStart().(MutableIterator[int])
+	iter.InsertAfter(1)
+	assert.Equal(t, []int{1, 2, 3}, iter.Slice().ToGoSlice())
+	assert.Panics(t, func() {
+		iter.InsertBefore(0)
+	})
+}
+
 // BENCHMARKING
 
 const benchmarkMaxSliceInserts = 100
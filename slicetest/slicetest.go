@@ -0,0 +1,543 @@
+// Package slicetest tests that implementations of slice.Slice behave like a Go
+// slice, and benchmarks them in the same way as the Slice types in the slice
+// package.
+//
+// The tests assume that the Slice is a value, like the types in the slice
+// package, so that the length of the Slice a method is called on isn't changed
+// by the Slice the method returns. Shared handles like slice.Synchronized don't
+// pass. Like appending to a Go slice, editing a Slice may change the elements
+// of the Slice it was edited from, so the tests don't read the elements of a
+// Slice after it has been edited
+package slicetest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/bhollier/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory creates a Slice of the implementation being tested, containing the
+// given elements. elems may be nil, and mustn't be kept by the Slice
+type Factory func(elems []int) slice.Slice[int]
+
+// The elements each test is run with, so the Slices being tested don't
+// always start empty
+var initialElems = [][]int{nil, {1}, {1, 2}}
+
+// RunConformance runs a subtest for each part of the slice.Slice interface,
+// checking that the Slices created by factory behave like a Go slice. The
+// ReverseIter subtest is skipped if the iterators can't move backwards, and
+// the RandomAccessIter and MutableIter subtests are skipped if the iterators
+// don't implement slice.RandomAccessIterator or slice.MutableIterator
+func RunConformance(t *testing.T, factory Factory) {
+	for _, test := range []struct {
+		name string
+		f    func(*testing.T, slice.Slice[int])
+	}{
+		{"Append", testAppend},
+		{"Prepend", testPrepend},
+		{"Insert", testInsert},
+		{"Erase", testErase},
+		{"Replace", testReplace},
+		{"Slice", testSlice},
+		{"SetGet", testSetGet},
+		{"Iter", testIter},
+		{"ReverseIter", testReverseIter},
+		{"RandomAccessIter", testRandomAccessIter},
+		{"MutableIter", testMutableIter},
+		{"DeepCopy", testDeepCopy},
+		{"LenCap", testLenCap},
+		{"OutOfRange", testOutOfRange},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			for _, elems := range initialElems {
+				test.f(t, factory(append([]int{}, elems...)))
+			}
+		})
+	}
+}
+
+func testAppend(t *testing.T, s slice.Slice[int]) {
+	fresh := s.DeepCopy()
+	base := fresh.DeepCopy().ToGoSlice()
+
+	s1 := s.Append(1)
+	assert.Equal(t, s.Len()+1, s1.Len())
+	assert.Equal(t, 1, s1.Get(s1.Len()-1))
+
+	s1 = s.Append(2, 3)
+	assert.Equal(t, s.Len()+2, s1.Len())
+	assert.Equal(t, 2, s1.Get(s1.Len()-2))
+	assert.Equal(t, 3, s1.Get(s1.Len()-1))
+
+	s1 = s.Append(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	assert.Equal(t,
+		[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		s1.Slice(s1.Len()-10, s1.Len()).ToGoSlice())
+
+	s1 = fresh.AppendSlice(slice.Wrap([]int{4, 5}))
+	assert.Equal(t, append(base, 4, 5), s1.ToGoSlice())
+}
+
+func testPrepend(t *testing.T, s slice.Slice[int]) {
+	fresh := s.DeepCopy()
+	base := fresh.DeepCopy().ToGoSlice()
+
+	s1 := s.Prepend(1)
+	assert.Equal(t, s.Len()+1, s1.Len())
+	assert.Equal(t, 1, s1.Get(0))
+
+	s1 = s.Prepend(3, 2)
+	assert.Equal(t, s.Len()+2, s1.Len())
+	assert.Equal(t, 3, s1.Get(0))
+	assert.Equal(t, 2, s1.Get(1))
+
+	s1 = s.Prepend(10, 9, 8, 7, 6, 5, 4, 3, 2, 1)
+	assert.Equal(t,
+		[]int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		s1.Slice(0, 10).ToGoSlice())
+
+	s1 = fresh.PrependSlice(slice.Wrap([]int{4, 5}))
+	assert.Equal(t, append([]int{4, 5}, base...), s1.ToGoSlice())
+}
+
+func testInsert(t *testing.T, s slice.Slice[int]) {
+	base := s.DeepCopy().ToGoSlice()
+	s1 := s.Append(1, 5)
+	s1 = s1.Insert(s.Len()+1, 2)
+	assert.Equal(t, append(base, 1, 2, 5), s1.ToGoSlice())
+
+	s1 = s1.InsertSlice(s.Len()+2, slice.Wrap([]int{3, 4}))
+	assert.Equal(t, append(base, 1, 2, 3, 4, 5), s1.ToGoSlice())
+
+	s1 = s1.Insert(0, 8, 9)
+	assert.Equal(t, append([]int{8, 9}, append(base, 1, 2, 3, 4, 5)...), s1.ToGoSlice())
+
+	s1 = s1.Insert(s1.Len())
+	assert.Equal(t, s.Len()+7, s1.Len())
+}
+
+func testErase(t *testing.T, s slice.Slice[int]) {
+	s1 := s.Append(1, 2, 3, 4, 5)
+	s1 = s1.Erase(s.Len() + 1)
+	assert.Equal(t, s.Len()+4, s1.Len())
+	assert.Equal(t, []int{1, 3, 4, 5}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.EraseRange(s.Len()+1, s.Len()+2)
+	assert.Equal(t, s.Len()+2, s1.Len())
+	assert.Equal(t, []int{1, 5}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Erase(s1.Len() - 1)
+	assert.Equal(t, s.Len()+1, s1.Len())
+	assert.Equal(t, 1, s1.Get(s1.Len()-1))
+
+	s1 = s.Prepend(1, 2, 3)
+	s1 = s1.Erase(0)
+	assert.Equal(t, s.Len()+2, s1.Len())
+	assert.Equal(t, 2, s1.Get(0))
+	assert.Equal(t, 3, s1.Get(1))
+
+	s1 = s1.EraseRange(0, s1.Len()-1)
+	assert.Equal(t, 0, s1.Len())
+}
+
+func testReplace(t *testing.T, s slice.Slice[int]) {
+	s1 := s.Append(1, 2, 3)
+	s1 = s1.Replace(s.Len(), s.Len()+2, 4)
+	assert.Equal(t, []int{4, 3}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Replace(s.Len()+1, s.Len()+2, 5, 6, 7)
+	assert.Equal(t, []int{4, 5, 6, 7}, s1.Slice(s.Len(), s1.Len()).ToGoSlice())
+
+	s1 = s1.Replace(s1.Len()-1, s1.Len())
+	assert.Equal(t, []int{4, 5, 6}, s1.Slice(s1.Len()-3, s1.Len()).ToGoSlice())
+
+	// Replace ranges all over a longer slice, checking it against a Go slice
+	expected := make([]int, 20)
+	for i := range expected {
+		expected[i] = i
+	}
+	s1 = s.Slice(0, 0).Append(expected...)
+	for _, r := range []struct {
+		i, j  int
+		elems []int
+	}{
+		{0, 0, []int{-1, -2, -3}},
+		{5, 9, nil},
+		{2, 3, []int{-4, -5, -6, -7}},
+		{10, 12, []int{-8}},
+		{15, 19, []int{-9, -10}},
+		{3, 17, nil},
+		{1, 1, []int{-11, -12, -13, -14, -15}},
+	} {
+		s1 = s1.Replace(r.i, r.j, r.elems...)
+		expected = append(expected[:r.i:r.i], append(append([]int{}, r.elems...), expected[r.j:]...)...)
+		assert.Equal(t, expected, s1.ToGoSlice())
+	}
+}
+
+func testSlice(t *testing.T, s slice.Slice[int]) {
+	s1 := s.Append(2, 3, 4)
+	sub := s1.Slice(s1.Len()-3, s1.Len())
+	assert.Equal(t, []int{2, 3, 4}, sub.ToGoSlice())
+	assert.Equal(t, 3, sub.Slice(1, 2).Get(0))
+
+	assert.Equal(t, 0, s1.Slice(0, 0).Len())
+	assert.Equal(t, 0, s1.Slice(s1.Len(), s1.Len()).Len())
+
+	// Erasing from a copy of a sub slice shouldn't change the sub slice
+	cpy := sub.DeepCopy()
+	erased := sub.Erase(1)
+	assert.Equal(t, []int{2, 4}, erased.ToGoSlice())
+
+	erased = cpy.EraseRange(0, 1)
+	assert.Equal(t, []int{4}, erased.ToGoSlice())
+}
+
+func testSetGet(t *testing.T, s slice.Slice[int]) {
+	s1 := s.Append(1, 2, 3)
+	// Like a Go slice, setting an element should be seen by copies of the slice
+	cpy := s1
+	expected := append([]int{}, s1.ToGoSlice()...)
+	for i := 0; i < s1.Len(); i++ {
+		s1.Set(i, i*10)
+		expected[i] = i * 10
+		assert.Equal(t, i*10, s1.Get(i), fmt.Sprintf("[%d]", i))
+		assert.Equal(t, i*10, cpy.Get(i), fmt.Sprintf("[%d]", i))
+	}
+	assert.Equal(t, expected, s1.ToGoSlice())
+
+	// Setting through an iterator should be seen by the slice and the iterator
+	iter := s1.IterStart()
+	for i := 0; iter.Next(); i++ {
+		iter.Set(iter.Get() + 1)
+		expected[i]++
+		assert.Equal(t, expected[i], iter.Get(), fmt.Sprintf("[%d]", i))
+		assert.Equal(t, expected[i], s1.Get(i), fmt.Sprintf("[%d]", i))
+	}
+	assert.Equal(t, expected, s1.ToGoSlice())
+
+	assert.Panics(t, func() {
+		s1.Set(-1, 0)
+	})
+	assert.Panics(t, func() {
+		s1.Set(s1.Len(), 0)
+	})
+}
+
+func testIter(t *testing.T, s slice.Slice[int]) {
+	elems := []int{2, 3, 4}
+	s1 := s.AppendSlice(slice.Wrap(elems))
+	iter := s1.Slice(s1.Len()-3, s1.Len()).IterStart()
+	i := 0
+	for iter.Next() {
+		assert.Equal(t, elems[i], iter.Get())
+		i++
+	}
+	assert.Equal(t, len(elems), i)
+	assert.False(t, iter.HasNext())
+
+	iter = s1.Slice(s1.Len()-3, s1.Len()).IterEnd()
+	assert.False(t, iter.HasNext())
+
+	assert.False(t, s1.Slice(0, 0).IterStart().Next())
+}
+
+func testReverseIter(t *testing.T, s slice.Slice[int]) {
+	elems := []int{2, 3, 4}
+	s1 := s.AppendSlice(slice.Wrap(elems))
+	if !s1.IterEnd().Prev() {
+		t.Skip("the iterators can't move backwards")
+	}
+	iter := s1.Slice(s1.Len()-3, s1.Len()).ReverseIterStart()
+	i := len(elems) - 1
+	for iter.Next() {
+		assert.Equal(t, elems[i], iter.Get())
+		i--
+	}
+	assert.Equal(t, -1, i)
+
+	iter = s1.Slice(s1.Len()-3, s1.Len()).ReverseIterEnd()
+	assert.True(t, iter.Prev())
+	assert.Equal(t, 2, iter.Get())
+}
+
+func testRandomAccessIter(t *testing.T, s slice.Slice[int]) {
+	elems := []int{2, 3, 4, 5, 6}
+	s1 := s.AppendSlice(slice.Wrap(elems))
+	s1 = s1.Slice(s1.Len()-len(elems), s1.Len())
+
+	iter, ok := s1.IterStart().(slice.RandomAccessIterator[int])
+	if !ok {
+		t.Skip("the iterators aren't RandomAccessIterators")
+	}
+	assert.Equal(t, -1, iter.Index())
+
+	iter.Seek(2)
+	assert.Equal(t, 2, iter.Index())
+	assert.Equal(t, 4, iter.Get())
+
+	iter.Advance(2)
+	assert.Equal(t, 6, iter.Get())
+	assert.False(t, iter.HasNext())
+
+	iter.Advance(-3)
+	assert.Equal(t, 1, iter.Index())
+	assert.Equal(t, 3, iter.Get())
+	assert.True(t, iter.Next())
+	assert.Equal(t, 4, iter.Get())
+
+	end := s1.IterEnd().(slice.RandomAccessIterator[int])
+	assert.Equal(t, len(elems), end.Index())
+	assert.Equal(t, 3, iter.Distance(end))
+	assert.Equal(t, -3, end.Distance(iter))
+
+	end.Seek(-1)
+	assert.True(t, end.Next())
+	assert.Equal(t, 2, end.Get())
+
+	iter.Seek(len(elems))
+	assert.False(t, iter.HasNext())
+	assert.Panics(t, func() {
+		iter.Advance(1)
+	})
+	assert.Panics(t, func() {
+		iter.Seek(-2)
+	})
+
+	empty := s.Slice(0, 0).IterEnd().(slice.RandomAccessIterator[int])
+	assert.Equal(t, 0, empty.Index())
+	empty.Seek(-1)
+	assert.False(t, empty.HasNext())
+}
+
+func testMutableIter(t *testing.T, s slice.Slice[int]) {
+	base := s.DeepCopy().ToGoSlice()
+	s1 := s.Append(10, 20, 30, 40, 50, 60)
+	iter, ok := s1.IterStart().(slice.MutableIterator[int])
+	if !ok {
+		t.Skip("the iterators aren't MutableIterators")
+	}
+	for iter.Next() {
+		switch iter.Get() {
+		case 20, 60:
+			iter.Remove()
+		case 30:
+			iter.InsertAfter(35)
+		case 50:
+			iter.InsertBefore(45)
+			assert.Equal(t, 50, iter.Get())
+		}
+	}
+	s1 = iter.Slice()
+	assert.Equal(t, append(base, 10, 30, 35, 40, 45, 50), s1.ToGoSlice())
+
+	// Remove elements consecutively
+	iter = s1.IterStart().(slice.MutableIterator[int])
+	for i := 0; i <= s.Len()+2; i++ {
+		iter.Next()
+	}
+	assert.Equal(t, 35, iter.Get())
+	iter.Remove()
+	assert.Equal(t, 30, iter.Get())
+	iter.Remove()
+	assert.True(t, iter.Next())
+	assert.Equal(t, 40, iter.Get())
+	s1 = iter.Slice()
+	assert.Equal(t, append(base, 10, 40, 45, 50), s1.ToGoSlice())
+
+	// Remove every element
+	iter = s1.Slice(s.Len(), s1.Len()).IterStart().(slice.MutableIterator[int])
+	for iter.Next() {
+		iter.Remove()
+	}
+	assert.Equal(t, 0, iter.Slice().Len())
+
+	// Insert into an empty slice
+	iter = s.Slice(0, 0).IterEnd().(slice.MutableIterator[int])
+	iter.InsertBefore(2)
+	iter.InsertBefore(3)
+	assert.False(t, iter.HasNext())
+	iter = iter.Slice().IterStart().(slice.MutableIterator[int])
+	iter.InsertAfter(1)
+	assert.Equal(t, []int{1, 2, 3}, iter.Slice().ToGoSlice())
+	assert.Panics(t, func() {
+		iter.InsertBefore(0)
+	})
+}
+
+func testDeepCopy(t *testing.T, s slice.Slice[int]) {
+	s1 := s.Append(1, 2, 3)
+	// ToGoSlice may return the slice's own array, so it's copied
+	expected := append([]int{}, s1.ToGoSlice()...)
+	cpy := s1.DeepCopy()
+	assert.Equal(t, expected, cpy.ToGoSlice())
+
+	// Changing the elements of either slice shouldn't change the other, even
+	// when the change is made in place
+	s1.Replace(0, 1, 100)
+	s1.Append(101)
+	assert.Equal(t, expected, cpy.ToGoSlice())
+
+	expected = append([]int{}, s1.ToGoSlice()...)
+	cpy.Replace(0, 1, 200)
+	cpy.Append(201)
+	assert.Equal(t, expected, s1.ToGoSlice())
+
+	assert.Equal(t, 0, s1.Slice(0, 0).DeepCopy().Len())
+}
+
+func testLenCap(t *testing.T, s slice.Slice[int]) {
+	// Methods returning a new slice shouldn't change the length of the slice
+	// they're called on
+	n := s.Len()
+	assert.Equal(t, n+1, s.Append(4).Len())
+	assert.Equal(t, n+1, s.Prepend(4).Len())
+	assert.Equal(t, n+1, s.Insert(n, 4).Len())
+	assert.Equal(t, n, s.Len())
+
+	s1 := s.Append(1, 2, 3)
+	for _, s := range []slice.Slice[int]{s, s1, s1.Slice(1, s1.Len()), s1.Slice(0, 0)} {
+		assert.Equal(t, len(s.ToGoSlice()), s.Len())
+		assert.GreaterOrEqual(t, s.Cap(), s.Len())
+	}
+
+	for i := 0; i <= s1.Len(); i++ {
+		for j := i; j <= s1.Len(); j++ {
+			sub := s1.Slice(i, j)
+			assert.Equal(t, j-i, sub.Len(), fmt.Sprintf("[%d:%d]", i, j))
+			assert.Equal(t, s1.ToGoSlice()[i:j], sub.ToGoSlice(), fmt.Sprintf("[%d:%d]", i, j))
+		}
+	}
+}
+
+func testOutOfRange(t *testing.T, s slice.Slice[int]) {
+	assert.Panics(t, func() {
+		s.Get(-1)
+	})
+	assert.Panics(t, func() {
+		s.Get(s.Len())
+	})
+	assert.Panics(t, func() {
+		s.Erase(s.Len())
+	})
+	assert.Panics(t, func() {
+		s.Slice(1, 0)
+	})
+	assert.Panics(t, func() {
+		s.Insert(s.Len() + 1)
+	})
+}
+
+// The benchmarks' parameters, the same as the slice package's own benchmarks
+const (
+	benchmarkMaxSliceInserts = 100
+	benchmarkMinSliceLen     = 10000
+	benchmarkMinReadAmount   = 1000
+)
+
+// RunBenchmarks runs a sub benchmark for each of the operations benchmarked in
+// the slice package, so that the results can be compared against its Slice
+// types
+func RunBenchmarks(b *testing.B, factory Factory) {
+	for _, bench := range []struct {
+		name string
+		f    func(*testing.B, *rand.Rand, slice.Slice[int])
+	}{
+		{"Append", benchmarkAppend},
+		{"Prepend", benchmarkPrepend},
+		{"Insert", benchmarkInsert},
+		{"Erase", benchmarkErase},
+		{"Index", benchmarkIndex},
+		{"Iter", benchmarkIter},
+	} {
+		bench := bench
+		b.Run(bench.name, func(b *testing.B) {
+			r := rand.New(rand.NewSource(time.Now().Unix()))
+			bench.f(b, r, factory(nil))
+		})
+	}
+}
+
+// Creates a random number of random elements, for each insert
+func randomElems(r *rand.Rand) []int {
+	elems := make([]int, r.Intn(benchmarkMaxSliceInserts-1)+1)
+	for i := range elems {
+		elems[i] = r.Int()
+	}
+	return elems
+}
+
+func benchmarkAppend(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	for i := 0; i < b.N; i++ {
+		s = s.Append(randomElems(r)...)
+	}
+}
+
+func benchmarkPrepend(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	for i := 0; i < b.N; i++ {
+		s = s.Prepend(randomElems(r)...)
+	}
+}
+
+func benchmarkInsert(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	s = addElems(b, r, s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s = s.InsertSlice(r.Intn(s.Len()), slice.Wrap(randomElems(r)))
+	}
+}
+
+// Appends enough elements to the slice to benchmark reading and erasing, with
+// the timer stopped
+func addElems(b *testing.B, r *rand.Rand, s slice.Slice[int]) slice.Slice[int] {
+	b.StopTimer()
+	for k := 0; k < benchmarkMinSliceLen; k++ {
+		s = s.Append(r.Int())
+	}
+	b.StartTimer()
+	return s
+}
+
+func benchmarkErase(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	s = addElems(b, r, s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if s.Len() == 0 {
+			s = addElems(b, r, s)
+		}
+		s = s.Erase(r.Intn(s.Len()))
+	}
+}
+
+func benchmarkIndex(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	s = addElems(b, r, s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		readAmount := r.Intn(benchmarkMinReadAmount-1) + 1
+		start := r.Intn(s.Len() - readAmount)
+		sum := 0
+		for j := 0; j < readAmount; j++ {
+			sum += s.Get(j + start)
+		}
+	}
+}
+
+func benchmarkIter(b *testing.B, r *rand.Rand, s slice.Slice[int]) {
+	s = addElems(b, r, s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		readAmount := r.Intn(benchmarkMinReadAmount-1) + 1
+		start := r.Intn(s.Len() - readAmount)
+		iter := s.Slice(start, start+readAmount).IterStart()
+		sum := 0
+		for iter.Next() {
+			sum += iter.Get()
+		}
+	}
+}
@@ -0,0 +1,102 @@
+//go:build go1.21
+
+package slice
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// LogValueLimit is the most elements LogValue includes, so logging a large
+// slice doesn't flood the log
+var LogValueLimit = 32
+
+// Gets the slog.Value of the slice, as a group with the type and length of the
+// slice and up to LogValueLimit of its elements. If elements were left out,
+// the group also has the number of them that were
+func logValue[T any](s Slice[T]) slog.Value {
+	n := s.Len()
+	elems := make([]T, 0, atMost(n, atLeast(LogValueLimit, 0)))
+	iter := s.IterStart()
+	for len(elems) < cap(elems) && iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+
+	attrs := []slog.Attr{
+		slog.String("type", fmt.Sprintf("%T", s)),
+		slog.Int("len", n),
+		slog.Any("elems", elems),
+	}
+	if omitted := n - len(elems); omitted > 0 {
+		attrs = append(attrs, slog.Int("omitted", omitted))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer
+func (s Wrapper[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Distributed[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Singly[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Doubly[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Persistent[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Rope[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s Ring[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s GapBuffer[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s SkipList[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer
+func (s RecordSlice[T]) LogValue() slog.Value {
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer, logging a snapshot of the slice. The
+// zero value is logged as an empty slice
+func (s Synchronized[T]) LogValue() slog.Value {
+	if s.state == nil {
+		s = Synchronize[T](Wrapper[T]{}).(Synchronized[T])
+	}
+	return logValue[T](s)
+}
+
+// LogValue implements slog.LogValuer. The zero value is logged as an empty
+// slice
+func (s COW[T]) LogValue() slog.Value {
+	if s.state == nil {
+		s = CopyOnWrite[T](Wrapper[T]{}).(COW[T])
+	}
+	return logValue[T](s)
+}
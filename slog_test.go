@@ -0,0 +1,71 @@
+//go:build go1.21
+
+package slice
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Logs the slice with a JSON handler, and decodes the attribute
+func logJSON(t *testing.T, s Slice[int]) map[string]any {
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "slice", s)
+	var record map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return record["slice"].(map[string]any)
+}
+
+// Tests logging a slice of 1, 2 and 3
+func commonSliceLogValueTest(t *testing.T, s Slice[int]) {
+	assert.Implements(t, (*slog.LogValuer)(nil), s)
+
+	attr := logJSON(t, s)
+	assert.Contains(t, attr["type"], "slice.")
+	assert.Equal(t, 3.0, attr["len"])
+	assert.Equal(t, []any{1.0, 2.0, 3.0}, attr["elems"])
+	assert.NotContains(t, attr, "omitted")
+}
+
+func TestLogValue(t *testing.T) {
+	elems := []int{1, 2, 3}
+	commonSliceLogValueTest(t, Wrap(elems))
+	commonSliceLogValueTest(t, EmptyDistributed[int](0, 2).Append(elems...))
+	commonSliceLogValueTest(t, SinglyFrom(elems))
+	commonSliceLogValueTest(t, DoublyFrom(elems))
+	commonSliceLogValueTest(t, PersistentFrom(elems))
+	commonSliceLogValueTest(t, RopeFrom(elems))
+	commonSliceLogValueTest(t, RingFrom(elems, 128, RingOverwrite))
+	commonSliceLogValueTest(t, GapBufferFrom(elems))
+	commonSliceLogValueTest(t, SkipListFrom(elems))
+	commonSliceLogValueTest(t, RecordSliceFrom[int](intRecords(elems...), intCodec{}, nil))
+	commonSliceLogValueTest(t, Synchronize(Wrap(elems)))
+	commonSliceLogValueTest(t, CopyOnWrite(Wrap(elems)))
+
+	// The zero values of the wrappers should be logged as empty slices
+	for _, empty := range []Slice[int]{Synchronized[int]{}, COW[int]{}} {
+		attr := logJSON(t, empty)
+		assert.Equal(t, 0.0, attr["len"])
+		assert.Equal(t, []any{}, attr["elems"])
+	}
+}
+
+func TestLogValue_Truncated(t *testing.T) {
+	defer func(limit int) { LogValueLimit = limit }(LogValueLimit)
+	LogValueLimit = 2
+
+	attr := logJSON(t, DistributedFrom([]int{1, 2, 3, 4, 5}))
+	assert.Equal(t, "slice.Distributed[int]", attr["type"])
+	assert.Equal(t, 5.0, attr["len"])
+	assert.Equal(t, []any{1.0, 2.0}, attr["elems"])
+	assert.Equal(t, 3.0, attr["omitted"])
+
+	LogValueLimit = 0
+	attr = logJSON(t, DistributedFrom([]int{1}))
+	assert.Equal(t, []any{}, attr["elems"])
+	assert.Equal(t, 1.0, attr["omitted"])
+}
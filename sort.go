@@ -0,0 +1,305 @@
+package slice
+
+import "sort"
+
+// Ordered is a constraint for any type that supports the < operator
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Returns -1 if a is less than b, 1 if a is greater than b, and 0
+// otherwise. NaN values are considered less than any other value
+func compareOrdered[T Ordered](a, b T) int {
+	// A value is NaN if it isn't equal to itself
+	aNaN, bNaN := a != a, b != b
+	if aNaN || bNaN {
+		if aNaN && bNaN {
+			return 0
+		} else if aNaN {
+			return -1
+		}
+		return 1
+	}
+
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Sort sorts the slice in ascending order, then returns the sorted slice.
+// Warning: like the sort package, the contents of s are sorted in place, so
+// other slices that share s's elements can be modified. The linked list types
+// are sorted by relinking their nodes, so other slices that share the nodes
+// should no longer be used
+func Sort[T Ordered](s Slice[T]) Slice[T] {
+	return SortFunc(s, compareOrdered[T])
+}
+
+// SortFunc sorts the slice in ascending order according to cmp, then returns
+// the sorted slice. cmp(a, b) should return a negative number when a < b, a
+// positive number when a > b and 0 when a == b. The sort isn't guaranteed to be
+// stable
+func SortFunc[T any](s Slice[T], cmp func(a, b T) int) Slice[T] {
+	return sortFunc(s, cmp, false)
+}
+
+// SortStableFunc sorts the slice in ascending order according to cmp, keeping
+// the original order of equal elements, then returns the sorted slice
+func SortStableFunc[T any](s Slice[T], cmp func(a, b T) int) Slice[T] {
+	return sortFunc(s, cmp, true)
+}
+
+func sortFunc[T any](s Slice[T], cmp func(a, b T) int, stable bool) Slice[T] {
+	switch s := s.(type) {
+	case Wrapper[T]:
+		sortGoSlice(s, cmp, stable)
+		return s
+
+	case Distributed[T]:
+		s.sortFunc(cmp, stable)
+		return s
+
+	// Sort a Persistent into a new slice, so the original doesn't change
+	case Persistent[T]:
+		elems := s.ToGoSlice()
+		sortGoSlice(elems, cmp, stable)
+		return PersistentFrom(elems)
+
+	// Sort the wrapped slice while holding the lock
+	case Synchronized[T]:
+		s.Update(func(slice Slice[T]) Slice[T] {
+			return sortFunc(slice, cmp, stable)
+		})
+		return s
+
+	// Copy any storage that is shared with a snapshot, then sort the wrapped
+	// slice
+	case COW[T]:
+		s.state.own(0, s.state.bucketOf(s.Len()))
+		s.state.slice = sortFunc(s.state.slice, cmp, stable)
+		return s
+
+	// Merge sort is always stable
+	case Singly[T]:
+		return s.sortFunc(cmp)
+	case Doubly[T]:
+		return s.sortFunc(cmp)
+
+	default:
+		// Sort the elements as a Go slice
+		elems := s.ToGoSlice()
+		sortGoSlice(elems, cmp, stable)
+		// Then copy them back
+		iter := s.IterStart()
+		for i := 0; iter.Next(); i++ {
+			iter.Set(elems[i])
+		}
+		return s
+	}
+}
+
+func sortGoSlice[T any](s []T, cmp func(a, b T) int, stable bool) {
+	less := func(i, j int) bool {
+		return cmp(s[i], s[j]) < 0
+	}
+	if stable {
+		sort.SliceStable(s, less)
+	} else {
+		sort.Slice(s, less)
+	}
+}
+
+// Merges the sorted slices a and b into dst, preferring elements from a when
+// they're equal
+func mergeGoSlices[T any](dst, a, b []T, cmp func(a, b T) int) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if cmp(b[j], a[i]) < 0 {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
+
+// Sorts each bucket, then merges the buckets together
+func (s Distributed[T]) sortFunc(cmp func(a, b T) int, stable bool) {
+	length := s.Len()
+	if length < 2 {
+		return
+	}
+
+	// Sort each bucket, and copy it onto a buffer. The sorted buckets are
+	// then "runs" in the buffer, that need to be merged
+	src := make([]T, 0, length)
+	runs := []int{0}
+	for b := range s.buckets {
+		bucket := s.bucket(b)
+		sortGoSlice(bucket, cmp, stable)
+		src = append(src, bucket...)
+		runs = append(runs, len(src))
+	}
+
+	// Merge each pair of runs, until there is only one run
+	dst := make([]T, length)
+	for len(runs) > 2 {
+		merged := []int{0}
+		for k := 0; k+1 < len(runs); k += 2 {
+			lo, mid, hi := runs[k], runs[k+1], runs[k+1]
+			// If there is a run to merge with
+			if k+2 < len(runs) {
+				hi = runs[k+2]
+			}
+			mergeGoSlices(dst[lo:hi], src[lo:mid], src[mid:hi], cmp)
+			merged = append(merged, hi)
+		}
+		runs = merged
+		src, dst = dst, src
+	}
+
+	// Copy the elements back into the buckets
+	i := 0
+	for b := range s.buckets {
+		i += copy(s.bucket(b), src[i:])
+	}
+}
+
+// Sorts the n nodes starting at head with a merge sort, returning the new first
+// and last nodes. The last node's next pointer is set to nil
+func mergeSortSingly[T any](head *singlyNode[T], n int, cmp func(a, b T) int) (*singlyNode[T], *singlyNode[T]) {
+	if n == 1 {
+		head.next = nil
+		return head, head
+	}
+
+	// Find the start of the second half
+	mid := head
+	for i := 1; i < n/2; i++ {
+		mid = mid.next
+	}
+	right := mid.next
+
+	// Sort each half
+	lFirst, lLast := mergeSortSingly(head, n/2, cmp)
+	rFirst, rLast := mergeSortSingly(right, n-n/2, cmp)
+
+	// Merge the halves
+	var first singlyNode[T]
+	tail := &first
+	for lFirst != nil && rFirst != nil {
+		if cmp(rFirst.elem, lFirst.elem) < 0 {
+			tail.next = rFirst
+			rFirst = rFirst.next
+		} else {
+			tail.next = lFirst
+			lFirst = lFirst.next
+		}
+		tail = tail.next
+	}
+
+	// Connect whichever half is left over
+	if lFirst != nil {
+		tail.next = lFirst
+		return first.next, lLast
+	}
+	tail.next = rFirst
+	return first.next, rLast
+}
+
+// Sorts the list by relinking its nodes
+func (s Singly[T]) sortFunc(cmp func(a, b T) int) Slice[T] {
+	if s.len < 2 {
+		return s
+	}
+
+	// Remember the node after the list, in case it's a slice of a larger list
+	after := s.end.next
+	s.start, s.end = mergeSortSingly(s.start, s.len, cmp)
+	s.end.next = after
+
+	return s
+}
+
+// Sorts the n nodes starting at head with a merge sort, returning the new first
+// and last nodes. Only the next pointers are updated, and the last node's next
+// pointer is set to nil
+func mergeSortDoubly[T any](head *doublyNode[T], n int, cmp func(a, b T) int) (*doublyNode[T], *doublyNode[T]) {
+	if n == 1 {
+		head.next = nil
+		return head, head
+	}
+
+	// Find the start of the second half
+	mid := head
+	for i := 1; i < n/2; i++ {
+		mid = mid.next
+	}
+	right := mid.next
+
+	// Sort each half
+	lFirst, lLast := mergeSortDoubly(head, n/2, cmp)
+	rFirst, rLast := mergeSortDoubly(right, n-n/2, cmp)
+
+	// Merge the halves
+	var first doublyNode[T]
+	tail := &first
+	for lFirst != nil && rFirst != nil {
+		if cmp(rFirst.elem, lFirst.elem) < 0 {
+			tail.next = rFirst
+			rFirst = rFirst.next
+		} else {
+			tail.next = lFirst
+			lFirst = lFirst.next
+		}
+		tail = tail.next
+	}
+
+	// Connect whichever half is left over
+	if lFirst != nil {
+		tail.next = lFirst
+		return first.next, lLast
+	}
+	tail.next = rFirst
+	return first.next, rLast
+}
+
+// Sorts the list by relinking its nodes
+func (s Doubly[T]) sortFunc(cmp func(a, b T) int) Slice[T] {
+	if s.len < 2 {
+		return s
+	}
+
+	// Remember the nodes either side of the list, in case it's a slice of a
+	// larger list
+	before, after := s.start.prev, s.end.next
+	s.start, s.end = mergeSortDoubly(s.start, s.len, cmp)
+
+	// Fix the previous pointers
+	prev := before
+	for node := s.start; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+
+	// Reconnect the list to the nodes either side of it
+	if before != nil {
+		before.next = s.start
+	}
+	s.end.next = after
+	if after != nil {
+		after.prev = s.end
+	}
+
+	return s
+}
@@ -0,0 +1,147 @@
+package slice
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceSortTest(t *testing.T, s Slice[int]) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 10, 100} {
+		elems := make([]int, n)
+		for i := range elems {
+			elems[i] = r.Intn(50)
+		}
+		s1 := s.Append(elems...)
+		sorted := Sort(s1.Slice(s.Len(), s1.Len()))
+
+		sort.Ints(elems)
+		commonSliceLenTest(t, sorted, n)
+		if n > 0 {
+			assert.Equal(t, elems, sorted.ToGoSlice())
+		}
+	}
+}
+
+func commonSliceSortStableTest(t *testing.T, s Slice[int]) {
+	r := rand.New(rand.NewSource(1))
+	// Sort by the tens, so the units give the original order
+	elems := make([]int, 100)
+	for i := range elems {
+		elems[i] = r.Intn(5)*100 + i
+	}
+	cmp := func(a, b int) int {
+		return a/100 - b/100
+	}
+
+	s1 := s.Append(elems...)
+	sorted := SortStableFunc(s1.Slice(s.Len(), s1.Len()), cmp)
+
+	sort.SliceStable(elems, func(i, j int) bool {
+		return cmp(elems[i], elems[j]) < 0
+	})
+	assert.Equal(t, elems, sorted.ToGoSlice())
+}
+
+func TestWrapper_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptySlice[int](0, 0))
+	commonSliceSortTest(t, Wrap([]int{1}))
+	commonSliceSortTest(t, Wrap([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptySlice[int](0, 0))
+}
+
+func TestDistributed_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyDistributed[int](0, 2))
+	commonSliceSortTest(t, EmptyDistributed[int](0, 3))
+	commonSliceSortTest(t, DistributedFrom([]int{1}))
+	commonSliceSortTest(t, DistributedFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptyDistributed[int](0, 3))
+}
+
+func TestSingly_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptySingly[int]())
+	commonSliceSortTest(t, SinglyFrom([]int{1}))
+	commonSliceSortTest(t, SinglyFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptySingly[int]())
+}
+
+func TestDoubly_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyDoubly[int]())
+	commonSliceSortTest(t, DoublyFrom([]int{1}))
+	commonSliceSortTest(t, DoublyFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptyDoubly[int]())
+}
+
+func TestPersistent_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyPersistent[int]())
+	commonSliceSortTest(t, PersistentFrom([]int{1}))
+	commonSliceSortTest(t, PersistentFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptyPersistent[int]())
+}
+
+func TestRope_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyRope[int]())
+	commonSliceSortTest(t, RopeFrom([]int{1}))
+	commonSliceSortTest(t, RopeFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptyRope[int]())
+}
+
+func TestRing_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceSortTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceSortTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+	commonSliceSortStableTest(t, EmptyRing[int](128, RingOverwrite))
+}
+
+func TestGapBuffer_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyGapBuffer[int](0))
+	commonSliceSortTest(t, GapBufferFrom([]int{1}))
+	commonSliceSortTest(t, GapBufferFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptyGapBuffer[int](0))
+}
+
+func TestSkipList_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptySkipList[int]())
+	commonSliceSortTest(t, SkipListFrom([]int{1}))
+	commonSliceSortTest(t, SkipListFrom([]int{1, 2}))
+	commonSliceSortStableTest(t, EmptySkipList[int]())
+}
+
+func TestRecordSlice_Sort(t *testing.T) {
+	commonSliceSortTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceSortTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceSortTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+	commonSliceSortStableTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+}
+
+func TestSynchronized_Sort(t *testing.T) {
+	// The slice is sorted in place, so every copy should be sorted
+	s := Synchronize(DistributedFrom([]int{3, 1, 2}))
+	cpy := s
+	assert.Equal(t, []int{1, 2, 3}, Sort(s).ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, cpy.ToGoSlice())
+}
+
+func TestCOW_Sort(t *testing.T) {
+	// The snapshot shouldn't be sorted
+	s := CopyOnWrite(EmptyDistributed[int](0, 2).Append(3, 1, 2))
+	snap := s.(COW[int]).Snapshot()
+	assert.Equal(t, []int{1, 2, 3}, Sort(s).ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3}, s.ToGoSlice())
+	assert.Equal(t, []int{3, 1, 2}, snap.ToGoSlice())
+}
+
+func TestCompareOrdered(t *testing.T) {
+	assert.Equal(t, -1, compareOrdered(1, 2))
+	assert.Equal(t, 1, compareOrdered("b", "a"))
+	assert.Equal(t, 0, compareOrdered(1.5, 1.5))
+
+	var zero float64
+	nan := zero / zero
+	assert.Equal(t, -1, compareOrdered(nan, 1))
+	assert.Equal(t, 1, compareOrdered(1, nan))
+	assert.Equal(t, 0, compareOrdered(nan, nan))
+}
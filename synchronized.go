@@ -0,0 +1,238 @@
+package slice
+
+import "sync"
+
+// The state of a Synchronized, which is shared by its copies
+type synchronizedState[T any] struct {
+	mu    sync.RWMutex
+	slice Slice[T]
+}
+
+// Synchronized is a Slice type that wraps another Slice behind a
+// sync.RWMutex, so that it can be used by multiple goroutines. Unlike the
+// other Slice types, the functions that modify the slice modify the wrapped
+// slice, and return the same Synchronized, so every copy of a Synchronized
+// sees the changes. Slice and DeepCopy copy the elements into a new
+// Synchronized, with its own lock
+type Synchronized[T any] struct {
+	state *synchronizedState[T]
+}
+
+// Synchronize creates a Synchronized Slice that wraps the given slice. The
+// given slice shouldn't be used directly afterwards
+func Synchronize[T any](s Slice[T]) Slice[T] {
+	return Synchronized[T]{state: &synchronizedState[T]{slice: s}}
+}
+
+// Gets the wrapped slice, holding a read lock
+func (s Synchronized[T]) load() Slice[T] {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return s.state.slice
+}
+
+// Update calls f with the wrapped slice while holding a write lock, then
+// replaces the wrapped slice with the slice f returns. f shouldn't use s, or
+// the slice it's given after it returns
+func (s Synchronized[T]) Update(f func(Slice[T]) Slice[T]) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	s.state.slice = f(s.state.slice)
+}
+
+// View calls f with the wrapped slice while holding a read lock. f shouldn't
+// modify the slice, or use it after it returns
+func (s Synchronized[T]) View(f func(Slice[T])) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	f(s.state.slice)
+}
+
+// Snapshot creates a deep copy of the wrapped slice, which isn't synchronized
+func (s Synchronized[T]) Snapshot() Slice[T] {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return s.state.slice.DeepCopy()
+}
+
+// Gets the elements of the given slice as a Wrapper, if it's a Synchronized.
+// This stops a Synchronized from being locked by two goroutines in different
+// orders, which could deadlock
+func unsynchronized[T any](elems Slice[T]) Slice[T] {
+	if _, ok := elems.(Synchronized[T]); ok {
+		return Wrap(elems.ToGoSlice())
+	}
+	return elems
+}
+
+func (s Synchronized[T]) Append(elems ...T) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.Append(elems...)
+	})
+	return s
+}
+
+func (s Synchronized[T]) AppendSlice(elems Slice[T]) Slice[T] {
+	elems = unsynchronized(elems)
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.AppendSlice(elems)
+	})
+	return s
+}
+
+func (s Synchronized[T]) Prepend(elems ...T) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.Prepend(elems...)
+	})
+	return s
+}
+
+func (s Synchronized[T]) PrependSlice(elems Slice[T]) Slice[T] {
+	elems = unsynchronized(elems)
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.PrependSlice(elems)
+	})
+	return s
+}
+
+// Slice creates a new Synchronized from a copy of the elements between i and
+// j (exclusive)
+func (s Synchronized[T]) Slice(i, j int) Slice[T] {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return Synchronize(s.state.slice.Slice(i, j).DeepCopy())
+}
+
+func (s Synchronized[T]) Erase(i int) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.Erase(i)
+	})
+	return s
+}
+
+func (s Synchronized[T]) EraseRange(i, j int) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.EraseRange(i, j)
+	})
+	return s
+}
+
+func (s Synchronized[T]) Insert(i int, elems ...T) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.Insert(i, elems...)
+	})
+	return s
+}
+
+func (s Synchronized[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	elems = unsynchronized(elems)
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.InsertSlice(i, elems)
+	})
+	return s
+}
+
+func (s Synchronized[T]) Replace(i, j int, elems ...T) Slice[T] {
+	s.Update(func(slice Slice[T]) Slice[T] {
+		return slice.Replace(i, j, elems...)
+	})
+	return s
+}
+
+func (s Synchronized[T]) Get(i int) T {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return s.state.slice.Get(i)
+}
+
+func (s Synchronized[T]) Set(i int, elem T) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	s.state.slice.Set(i, elem)
+}
+
+// IterStart creates an iterator over a snapshot of the slice, so the slice
+// isn't locked while it's iterated over. Changes made through the iterator
+// only change the snapshot
+func (s Synchronized[T]) IterStart() Iterator[T] {
+	return s.Snapshot().IterStart()
+}
+
+// ReverseIterStart creates a reverse iterator over a snapshot of the slice
+func (s Synchronized[T]) ReverseIterStart() Iterator[T] {
+	return s.Snapshot().ReverseIterStart()
+}
+
+// IterEnd creates an iterator over a snapshot of the slice, pointed to the
+// end
+func (s Synchronized[T]) IterEnd() Iterator[T] {
+	return s.Snapshot().IterEnd()
+}
+
+// ReverseIterEnd creates a reverse iterator over a snapshot of the slice,
+// pointed to the end
+func (s Synchronized[T]) ReverseIterEnd() Iterator[T] {
+	return s.Snapshot().ReverseIterEnd()
+}
+
+// LockedIterator is an iterator that holds a read lock on a Synchronized
+// slice until it's closed. The elements can't be set, as that would need a
+// write lock
+type LockedIterator[T any] struct {
+	Iterator[T]
+	state *synchronizedState[T]
+}
+
+// LockedIterStart creates an iterator over the slice, that holds a read lock
+// until Close is called. Like View, the slice can't be modified until the
+// iterator is closed, including by the goroutine that's iterating
+func (s Synchronized[T]) LockedIterStart() *LockedIterator[T] {
+	s.state.mu.RLock()
+	return &LockedIterator[T]{Iterator: s.state.slice.IterStart(), state: s.state}
+}
+
+// LockedIterEnd creates an iterator pointed to the end of the slice, that
+// holds a read lock until Close is called
+func (s Synchronized[T]) LockedIterEnd() *LockedIterator[T] {
+	s.state.mu.RLock()
+	return &LockedIterator[T]{Iterator: s.state.slice.IterEnd(), state: s.state}
+}
+
+// Set always panics, as the iterator only holds a read lock. Use Update
+// instead
+func (i *LockedIterator[T]) Set(T) {
+	panic("slice: can't set an element while holding a read lock, use Update instead")
+}
+
+// Close releases the read lock. Calling Close more than once does nothing
+func (i *LockedIterator[T]) Close() {
+	if i.state != nil {
+		i.state.mu.RUnlock()
+		i.state = nil
+	}
+}
+
+// DeepCopy creates a new Synchronized from a deep copy of the slice
+func (s Synchronized[T]) DeepCopy() Slice[T] {
+	return Synchronize(s.Snapshot())
+}
+
+func (s Synchronized[T]) Len() int {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return s.state.slice.Len()
+}
+
+func (s Synchronized[T]) Cap() int {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return s.state.slice.Cap()
+}
+
+// ToGoSlice copies the elements into a new Go slice while holding a read lock,
+// as the wrapped slice's own array could be changed once the lock is released
+func (s Synchronized[T]) ToGoSlice() []T {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	return append([]T(nil), s.state.slice.ToGoSlice()...)
+}
@@ -0,0 +1,189 @@
+package slice
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronized_Iter(t *testing.T) {
+	commonSliceIterTest(t, Synchronize(EmptyDistributed[int](0, 2)))
+	commonSliceIterTest(t, Synchronize(DistributedFrom([]int{1})))
+	commonSliceIterTest(t, Synchronize(DistributedFrom([]int{1, 2})))
+}
+
+func TestSynchronized_ReverseIter(t *testing.T) {
+	commonSliceReverseIterTest(t, Synchronize(EmptyDistributed[int](0, 2)))
+	commonSliceReverseIterTest(t, Synchronize(DistributedFrom([]int{1})))
+	commonSliceReverseIterTest(t, Synchronize(DistributedFrom([]int{1, 2})))
+}
+
+func TestSynchronized_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, Synchronize(EmptyDistributed[int](0, 2)))
+	commonSliceRandomAccessIterTest(t, Synchronize(DistributedFrom([]int{1})))
+	commonSliceRandomAccessIterTest(t, Synchronize(DistributedFrom([]int{1, 2})))
+}
+
+func TestSynchronized_Shared(t *testing.T) {
+	// Every copy of the slice should see the changes
+	s := Synchronize(DistributedFrom([]int{1, 2}))
+	cpy := s
+	s.Append(3)
+	s.Prepend(0)
+	s.Insert(2, 5)
+	assert.Equal(t, []int{0, 1, 5, 2, 3}, cpy.ToGoSlice())
+	s.Erase(2)
+	s.Set(0, -1)
+	assert.Equal(t, []int{-1, 1, 2, 3}, cpy.ToGoSlice())
+
+	// Slices and deep copies shouldn't share the elements
+	sliced := s.Slice(1, 3)
+	sliced.Set(0, 10)
+	deep := s.DeepCopy()
+	deep.Append(4)
+	assert.Equal(t, []int{-1, 1, 2, 3}, s.ToGoSlice())
+	assert.Equal(t, []int{10, 2}, sliced.ToGoSlice())
+
+	// Appending the slice to itself shouldn't deadlock
+	s.AppendSlice(s)
+	assert.Equal(t, []int{-1, 1, 2, 3, -1, 1, 2, 3}, s.ToGoSlice())
+
+	s.(Synchronized[int]).Update(func(s Slice[int]) Slice[int] {
+		return s.Slice(0, 2)
+	})
+	assert.Equal(t, []int{-1, 1}, cpy.ToGoSlice())
+	s.(Synchronized[int]).View(func(s Slice[int]) {
+		commonSliceLenTest(t, s, 2)
+	})
+}
+
+func TestSynchronized_SnapshotIter(t *testing.T) {
+	// The iterator shouldn't see changes made after it was created
+	s := Synchronize(DistributedFrom([]int{1, 2, 3}))
+	iter := s.IterStart()
+	s.Append(4)
+	s.Set(0, 0)
+	var elems []int
+	for iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+	assert.Equal(t, []int{1, 2, 3}, elems)
+}
+
+func TestSynchronized_LockedIter(t *testing.T) {
+	s := Synchronize(DistributedFrom([]int{1, 2, 3})).(Synchronized[int])
+	iter := s.LockedIterStart()
+	var elems []int
+	for iter.Next() {
+		elems = append(elems, iter.Get())
+	}
+	assert.Equal(t, []int{1, 2, 3}, elems)
+	assert.Panics(t, func() {
+		iter.Set(0)
+	})
+
+	// Writing should wait until the iterator is closed
+	written := make(chan struct{})
+	go func() {
+		s.Append(4)
+		close(written)
+	}()
+	select {
+	case <-written:
+		t.Error("slice was written to while it was locked")
+	case <-time.After(10 * time.Millisecond):
+	}
+	iter.Close()
+	iter.Close()
+	<-written
+
+	iter = s.LockedIterEnd()
+	assert.True(t, iter.Prev())
+	assert.Equal(t, 4, iter.Get())
+	iter.Close()
+}
+
+func TestSynchronized_Concurrent(t *testing.T) {
+	s := Synchronize(EmptyDistributed[int](0, 4))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				s.Append(g)
+				s.Prepend(g)
+				s.Get(0)
+				iter := s.IterStart()
+				for iter.Next() {
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	commonSliceLenTest(t, s, 1600)
+	counts := make(map[int]int)
+	for _, elem := range s.ToGoSlice() {
+		counts[elem]++
+	}
+	for g := 0; g < 8; g++ {
+		assert.Equal(t, 200, counts[g])
+	}
+}
+
+func TestSynchronized_ToGoSlice(t *testing.T) {
+	// The Go slice should be a copy, so it can be read while another goroutine
+	// sets the elements
+	s := Synchronize(Wrap([]int{1, 2, 3}))
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.Set(0, i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			elems := s.ToGoSlice()
+			assert.Equal(t, []int{2, 3}, elems[1:])
+		}
+	}()
+	wg.Wait()
+
+	elems := s.ToGoSlice()
+	elems[1] = 0
+	assert.Equal(t, []int{99, 2, 3}, s.ToGoSlice())
+}
+
+// BENCHMARKING
+
+func BenchmarkSynchronized_Append(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceAppendBenchmark(b, r, Synchronize(EmptyDistributed[int](0, 2)))
+}
+
+func BenchmarkSynchronized_Prepend(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSlicePrependBenchmark(b, r, Synchronize(EmptyDistributed[int](0, 2)))
+}
+
+func BenchmarkSynchronized_Erase(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceEraseBenchmark(b, r, Synchronize(EmptyDistributed[int](0, 2)))
+}
+
+func BenchmarkSynchronized_Index(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIndexBenchmark(b, r, Synchronize(EmptyDistributed[int](0, 2)))
+}
+
+func BenchmarkSynchronized_Iter(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	commonSliceIterBenchmark(b, r, Synchronize(EmptyDistributed[int](0, 2)))
+}
@@ -0,0 +1,84 @@
+package slice
+
+// Creates a slice of the same type as like, from a Go slice. Slice types that
+// aren't part of this package are created as a Wrapper
+func from[T, U any](like Slice[T], elems []U) Slice[U] {
+	switch like := like.(type) {
+	case Distributed[T]:
+		return EmptyDistributed[U](0, like.bucketCap).Append(elems...)
+	case Singly[T]:
+		return SinglyFrom(elems)
+	case Doubly[T]:
+		return DoublyFrom(elems)
+	case Persistent[T]:
+		return PersistentFrom(elems)
+	case Rope[T]:
+		return RopeFrom(elems)
+	// Make sure none of the elements are overwritten or rejected
+	case Ring[T]:
+		return RingFrom(elems, atLeast(like.Cap(), len(elems)), like.mode)
+	case GapBuffer[T]:
+		return GapBufferFrom(elems)
+	case SkipList[T]:
+		return SkipListFrom(elems)
+	// The elements can only be encoded if they're the same type
+	case RecordSlice[T]:
+		if codec, ok := like.codec.(Codec[U]); ok {
+			return EmptyRecordSlice[U](0, len(elems), codec).Append(elems...)
+		}
+		return Wrap(elems)
+	case Synchronized[T]:
+		return Synchronize(from(like.load(), elems))
+	case COW[T]:
+		return CopyOnWrite(from(like.state.slice, elems))
+	default:
+		return Wrap(elems)
+	}
+}
+
+// Map creates a new slice from the result of calling f on each element of s.
+// The new slice is the same type as s, so mapping a Doubly creates a Doubly,
+// and mapping a Distributed creates a Distributed with the same bucket capacity
+func Map[T, U any](s Slice[T], f func(T) U) Slice[U] {
+	elems := make([]U, 0, s.Len())
+	iter := s.IterStart()
+	for iter.Next() {
+		elems = append(elems, f(iter.Get()))
+	}
+	return from(s, elems)
+}
+
+// Filter creates a new slice from the elements of s that keep returns true
+// for. The new slice is the same type as s, and s isn't modified
+func Filter[T any](s Slice[T], keep func(T) bool) Slice[T] {
+	var elems []T
+	iter := s.IterStart()
+	for iter.Next() {
+		if keep(iter.Get()) {
+			elems = append(elems, iter.Get())
+		}
+	}
+	return from(s, elems)
+}
+
+// Reduce calls f on each element of s in order, passing the result of the
+// previous call (or init for the first call), and returns the final result
+func Reduce[T, U any](s Slice[T], init U, f func(U, T) U) U {
+	result := init
+	iter := s.IterStart()
+	for iter.Next() {
+		result = f(result, iter.Get())
+	}
+	return result
+}
+
+// FlatMap creates a new slice by concatenating the slices returned by calling
+// f on each element of s. The new slice is the same type as s
+func FlatMap[T, U any](s Slice[T], f func(T) Slice[U]) Slice[U] {
+	var elems []U
+	iter := s.IterStart()
+	for iter.Next() {
+		elems = append(elems, f(iter.Get()).ToGoSlice()...)
+	}
+	return from(s, elems)
+}
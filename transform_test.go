@@ -0,0 +1,144 @@
+package slice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commonSliceTransformTest(t *testing.T, s Slice[int]) {
+	s1 := s.Append(1, 2, 3, 4, 5)
+	s1 = s1.Slice(s.Len(), s1.Len())
+
+	mapped := Map(s1, strconv.Itoa)
+	assert.IsType(t, from[int, string](s1, nil), mapped)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, mapped.ToGoSlice())
+
+	filtered := Filter(s1, func(elem int) bool {
+		return elem%2 == 1
+	})
+	assert.IsType(t, s1, filtered)
+	assert.Equal(t, []int{1, 3, 5}, filtered.ToGoSlice())
+	// The original slice shouldn't be modified
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s1.ToGoSlice())
+
+	filtered = Filter(s1, func(elem int) bool {
+		return false
+	})
+	commonSliceLenTest(t, filtered, 0)
+
+	sum := Reduce(s1, 0, func(sum, elem int) int {
+		return sum + elem
+	})
+	assert.Equal(t, 15, sum)
+
+	str := Reduce(s1, "", func(str string, elem int) string {
+		return str + strconv.Itoa(elem)
+	})
+	assert.Equal(t, "12345", str)
+
+	flat := FlatMap(s1.Slice(0, 3), func(elem int) Slice[int] {
+		return Wrap([]int{elem, elem * 10})
+	})
+	assert.IsType(t, s1, flat)
+	assert.Equal(t, []int{1, 10, 2, 20, 3, 30}, flat.ToGoSlice())
+}
+
+func TestWrapper_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptySlice[int](0, 0))
+	commonSliceTransformTest(t, Wrap([]int{1}))
+	commonSliceTransformTest(t, Wrap([]int{1, 2}))
+}
+
+func TestDistributed_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyDistributed[int](0, 2))
+	commonSliceTransformTest(t, DistributedFrom([]int{1}))
+	commonSliceTransformTest(t, DistributedFrom([]int{1, 2}))
+
+	// The bucket capacity should be kept
+	s := EmptyDistributed[int](0, 3).Append(1, 2, 3, 4)
+	filtered := Filter(s, func(elem int) bool {
+		return elem > 1
+	})
+	assert.Equal(t, 3, filtered.(Distributed[int]).bucketCap)
+	mapped := Map(s, strconv.Itoa)
+	assert.Equal(t, 3, mapped.(Distributed[string]).bucketCap)
+}
+
+func TestSingly_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptySingly[int]())
+	commonSliceTransformTest(t, SinglyFrom([]int{1}))
+	commonSliceTransformTest(t, SinglyFrom([]int{1, 2}))
+}
+
+func TestDoubly_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyDoubly[int]())
+	commonSliceTransformTest(t, DoublyFrom([]int{1}))
+	commonSliceTransformTest(t, DoublyFrom([]int{1, 2}))
+}
+
+func TestPersistent_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyPersistent[int]())
+	commonSliceTransformTest(t, PersistentFrom([]int{1}))
+	commonSliceTransformTest(t, PersistentFrom([]int{1, 2}))
+}
+
+func TestRope_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyRope[int]())
+	commonSliceTransformTest(t, RopeFrom([]int{1}))
+	commonSliceTransformTest(t, RopeFrom([]int{1, 2}))
+}
+
+func TestRing_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyRing[int](128, RingOverwrite))
+	commonSliceTransformTest(t, RingFrom([]int{1}, 128, RingOverwrite))
+	commonSliceTransformTest(t, RingFrom([]int{1, 2}, 128, RingReject))
+}
+
+func TestGapBuffer_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyGapBuffer[int](0))
+	commonSliceTransformTest(t, GapBufferFrom([]int{1}))
+	commonSliceTransformTest(t, GapBufferFrom([]int{1, 2}))
+}
+
+func TestSkipList_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptySkipList[int]())
+	commonSliceTransformTest(t, SkipListFrom([]int{1}))
+	commonSliceTransformTest(t, SkipListFrom([]int{1, 2}))
+}
+
+func TestRecordSlice_Transform(t *testing.T) {
+	commonSliceTransformTest(t, EmptyRecordSlice[int](0, 0, intCodec{}))
+	commonSliceTransformTest(t, RecordSliceFrom[int](intRecords(1), intCodec{}, nil))
+	commonSliceTransformTest(t, RecordSliceFrom[int](intRecords(1, 2), intCodec{}, nil))
+}
+
+func TestSynchronized_Transform(t *testing.T) {
+	s := Synchronize(DistributedFrom([]int{1, 2, 3, 4, 5}))
+	mapped := Map(s, strconv.Itoa)
+	assert.IsType(t, Synchronized[string]{}, mapped)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, mapped.ToGoSlice())
+
+	filtered := Filter(s, func(elem int) bool {
+		return elem%2 == 1
+	})
+	assert.IsType(t, s, filtered)
+	assert.Equal(t, []int{1, 3, 5}, filtered.ToGoSlice())
+	// The original slice shouldn't be modified
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToGoSlice())
+}
+
+func TestCOW_Transform(t *testing.T) {
+	s := CopyOnWrite(DistributedFrom([]int{1, 2, 3, 4, 5}))
+	mapped := Map(s, strconv.Itoa)
+	assert.IsType(t, COW[string]{}, mapped)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, mapped.ToGoSlice())
+
+	filtered := Filter(s, func(elem int) bool {
+		return elem%2 == 1
+	})
+	assert.IsType(t, s, filtered)
+	assert.Equal(t, []int{1, 3, 5}, filtered.ToGoSlice())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.ToGoSlice())
+}
@@ -1,5 +1,7 @@
 package slice
 
+import "fmt"
+
 // Wrapper is a Slice type, implemented as a very thin wrapper around a Go slice
 type Wrapper[T any] []T
 
@@ -33,6 +35,47 @@ func (s Wrapper[T]) Slice(i, j int) Slice[T] {
 	return Wrap(s[i:j])
 }
 
+func (s Wrapper[T]) Erase(i int) Slice[T] {
+	return s.Replace(i, i+1)
+}
+
+func (s Wrapper[T]) EraseRange(i, j int) Slice[T] {
+	return s.Replace(i, j+1)
+}
+
+func (s Wrapper[T]) Insert(i int, elems ...T) Slice[T] {
+	return s.Replace(i, i, elems...)
+}
+
+func (s Wrapper[T]) InsertSlice(i int, elems Slice[T]) Slice[T] {
+	return s.Replace(i, i, elems.ToGoSlice()...)
+}
+
+func (s Wrapper[T]) Replace(i, j int, elems ...T) Slice[T] {
+	checkRange(i, j, len(s))
+
+	// Calculate the new length
+	newLen := len(s) - (j - i) + len(elems)
+
+	// If the elements fit in the underlying array
+	if newLen <= cap(s) {
+		// Resize the slice in place
+		r := s[:newLen]
+		// Move the tail of the slice into position
+		copy(r[i+len(elems):], s[j:])
+		// Copy the elements in
+		copy(r[i:], elems)
+		return r
+	}
+
+	// Otherwise create a new slice, and copy everything onto it
+	r := make(Wrapper[T], 0, newLen)
+	r = append(r, s[:i]...)
+	r = append(r, elems...)
+	r = append(r, s[j:]...)
+	return r
+}
+
 func (s Wrapper[T]) Get(i int) T {
 	return s[i]
 }
@@ -78,6 +121,49 @@ func (i *wrapperIterator[T]) Set(elem T) {
 	i.slice[i.index] = elem
 }
 
+func (i *wrapperIterator[T]) Advance(n int) {
+	i.Seek(i.index + n)
+}
+
+func (i *wrapperIterator[T]) Seek(index int) {
+	if index < -1 || index > len(i.slice) {
+		panic(fmt.Sprintf("index [%d] out of range", index))
+	}
+	i.index = index
+}
+
+func (i *wrapperIterator[T]) Index() int {
+	return i.index
+}
+
+func (i *wrapperIterator[T]) Distance(other RandomAccessIterator[T]) int {
+	return other.Index() - i.index
+}
+
+func (i *wrapperIterator[T]) InsertBefore(elem T) {
+	if i.index < 0 {
+		panic("slice: can't insert before the start of the slice")
+	}
+	i.slice = Wrapper[T](i.slice).Insert(i.index, elem).(Wrapper[T])
+	i.index++
+}
+
+func (i *wrapperIterator[T]) InsertAfter(elem T) {
+	if i.index >= len(i.slice) {
+		panic("slice: can't insert after the end of the slice")
+	}
+	i.slice = Wrapper[T](i.slice).Insert(i.index+1, elem).(Wrapper[T])
+}
+
+func (i *wrapperIterator[T]) Remove() {
+	i.slice = Wrapper[T](i.slice).Erase(i.index).(Wrapper[T])
+	i.index--
+}
+
+func (i *wrapperIterator[T]) Slice() Slice[T] {
+	return Wrap(i.slice)
+}
+
 func IterStart[T any](slice []T) Iterator[T] {
 	i := wrapperIterator[T]{
 		slice: slice,
@@ -30,6 +30,18 @@ func TestWrapper_Slice(t *testing.T) {
 	commonSliceSliceTest(t, Wrap([]int{1, 2}))
 }
 
+func TestWrapper_Erase(t *testing.T) {
+	commonSliceEraseTest(t, EmptySlice[int](0, 0))
+	commonSliceEraseTest(t, Wrap([]int{1}))
+	commonSliceEraseTest(t, Wrap([]int{1, 2}))
+}
+
+func TestWrapper_Replace(t *testing.T) {
+	commonSliceReplaceTest(t, EmptySlice[int](0, 0))
+	commonSliceReplaceTest(t, Wrap([]int{1}))
+	commonSliceReplaceTest(t, Wrap([]int{1, 2}))
+}
+
 func TestWrapper_Iter(t *testing.T) {
 	commonSliceIterTest(t, EmptySlice[int](0, 0))
 	commonSliceIterTest(t, Wrap([]int{1}))
@@ -42,6 +54,18 @@ func TestWrapper_ReverseIter(t *testing.T) {
 	commonSliceReverseIterTest(t, Wrap([]int{1, 2}))
 }
 
+func TestWrapper_RandomAccessIter(t *testing.T) {
+	commonSliceRandomAccessIterTest(t, EmptySlice[int](0, 0))
+	commonSliceRandomAccessIterTest(t, Wrap([]int{1}))
+	commonSliceRandomAccessIterTest(t, Wrap([]int{1, 2}))
+}
+
+func TestWrapper_MutableIter(t *testing.T) {
+	commonSliceMutableIterTest(t, EmptySlice[int](0, 0))
+	commonSliceMutableIterTest(t, Wrap([]int{1}))
+	commonSliceMutableIterTest(t, Wrap([]int{1, 2}))
+}
+
 // BENCHMARKING
 
 func BenchmarkWrapper_Append(b *testing.B) {